@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/pkg/response"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+type options struct {
+	config     gcs.Path // gs://path/to/config/proto, s3://path/to/config/proto or file:///path/to/config/proto
+	creds      string
+	s3Endpoint string
+	s3Region   string
+	gridPrefix string
+	listen     string
+}
+
+func (o *options) validate() error {
+	if o.config.String() == "" {
+		return errors.New("empty --config")
+	}
+	if strings.HasPrefix(o.config.String(), "s3://") && o.s3Endpoint == "" {
+		return fmt.Errorf("--config=%s: requires --s3-endpoint", o.config)
+	}
+	if o.listen == "" {
+		return errors.New("empty --listen")
+	}
+	return nil
+}
+
+func gatherOptions() options {
+	var o options
+	flag.Var(&o.config, "config", "gs://path/to/config.pb, s3://path/to/config.pb or file:///path/to/config.pb")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.s3Endpoint, "s3-endpoint", "", "Read and write s3:// paths from this S3-compatible endpoint, signing requests with $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY when set")
+	flag.StringVar(&o.s3Region, "s3-region", "", "Sign S3 requests for this region (us-east-1 if empty)")
+	flag.StringVar(&o.gridPrefix, "grid-prefix", "grid", "Join this with the test group name to find its grid state, relative to the config")
+	flag.StringVar(&o.listen, "listen", ":8080", "Serve requests on this address")
+	flag.Parse()
+	return o
+}
+
+func main() {
+	opt := gatherOptions()
+	if err := opt.validate(); err != nil {
+		logrus.Fatalf("Invalid flags: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, release, err := gcs.NewDefaultClient(ctx, gcs.ClientOptions{
+		Creds:      opt.creds,
+		RequireGCS: strings.HasPrefix(opt.config.String(), "gs://"),
+		S3Endpoint: opt.s3Endpoint,
+		S3Region:   opt.s3Region,
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to create storage client: %v", err)
+	}
+	defer release()
+
+	mux := http.NewServeMux()
+	mux.Handle(response.Prefix, response.NewServer(client, opt.config, opt.gridPrefix))
+	logrus.WithField("listen", opt.listen).Info("Serving")
+	if err := http.ListenAndServe(opt.listen, mux); err != nil {
+		logrus.Fatalf("Failed to serve: %v", err)
+	}
+}
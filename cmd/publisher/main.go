@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/pkg/publisher"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// options configures the publisher
+type options struct {
+	builds       []gcs.Path // gs://bucket/logs/job/123/, s3://bucket/logs/job/123/ or file:///path/to/job/123/
+	project      string
+	creds        string
+	s3Endpoint   string
+	s3Region     string
+	secretFile   string
+	confirm      bool
+	debug        bool
+	buildTimeout time.Duration
+}
+
+// validate ensures sane options
+func (o *options) validate() error {
+	if len(o.builds) == 0 {
+		return errors.New("specify at least one build path")
+	}
+	if o.project == "" {
+		return errors.New("empty --project")
+	}
+	if o.confirm && o.secretFile == "" {
+		return errors.New("--confirm requires --secret-file")
+	}
+	for _, b := range o.builds {
+		if strings.HasPrefix(b.String(), "s3://") && o.s3Endpoint == "" {
+			return fmt.Errorf("build %s: requires --s3-endpoint", b)
+		}
+	}
+	return nil
+}
+
+// gatherFlagOptions reads options from flags, treating each argument as a build path.
+func gatherFlagOptions(fs *flag.FlagSet, args ...string) (options, error) {
+	var o options
+	fs.StringVar(&o.project, "project", "", "Create invocations in this GCP project")
+	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	fs.StringVar(&o.s3Endpoint, "s3-endpoint", "", "Read s3:// builds from this S3-compatible endpoint, signing requests with $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY when set")
+	fs.StringVar(&o.s3Region, "s3-region", "", "Sign S3 requests for this region (us-east-1 if empty)")
+	fs.StringVar(&o.secretFile, "secret-file", "", "/path/to/file holding the authorization token of each invocation, which must not change between attempts")
+	fs.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
+	fs.BoolVar(&o.debug, "debug", false, "Log debug lines if set")
+	fs.DurationVar(&o.buildTimeout, "build-timeout", 3*time.Minute, "Maximum time to wait to read and publish each build")
+	if err := fs.Parse(args); err != nil {
+		return o, err
+	}
+	for _, arg := range fs.Args() {
+		p, err := gcs.NewPath(arg)
+		if err != nil {
+			return o, fmt.Errorf("bad build %q: %w", arg, err)
+		}
+		if !strings.HasSuffix(p.Object(), "/") {
+			if p, err = gcs.NewPath(arg + "/"); err != nil {
+				return o, fmt.Errorf("bad build %q: %w", arg, err)
+			}
+		}
+		o.builds = append(o.builds, *p)
+	}
+	return o, nil
+}
+
+func main() {
+	opt, err := gatherFlagOptions(flag.CommandLine, os.Args[1:]...)
+	if err == nil {
+		err = opt.validate()
+	}
+	if err != nil {
+		logrus.Fatalf("Invalid flags: %v", err)
+	}
+	if !opt.confirm {
+		logrus.Warning("--confirm=false (DRY-RUN): will not write to ResultStore")
+	}
+	if opt.debug {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, release, err := gcs.NewDefaultClient(ctx, gcs.ClientOptions{
+		Creds:      opt.creds,
+		S3Endpoint: opt.s3Endpoint,
+		S3Region:   opt.s3Region,
+	})
+	if err != nil {
+		logrus.Fatalf("Failed to create storage client: %v", err)
+	}
+	defer release()
+
+	var rsClient *resultstore.Client
+	if opt.confirm {
+		secret, err := ioutil.ReadFile(opt.secretFile)
+		if err != nil {
+			logrus.Fatalf("Failed to read --secret-file: %v", err)
+		}
+		conn, err := resultstore.Connect(ctx, opt.creds)
+		if err != nil {
+			logrus.Fatalf("Failed to connect to ResultStore: %v", err)
+		}
+		defer conn.Close()
+		rsClient = resultstore.NewClient(conn).WithSecret(resultstore.Secret(strings.TrimSpace(string(secret))))
+	}
+
+	var failed bool
+	for _, p := range opt.builds {
+		log := logrus.WithField("build", p.String())
+		if err := publish(ctx, client, rsClient, opt.project, gcs.Build{Path: p}, opt.buildTimeout); err != nil {
+			log.WithError(err).Error("Failed to publish build")
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// publish reads the build and uploads it to ResultStore, or logs what it would upload when the rsClient is nil.
+func publish(parent context.Context, client gcs.Downloader, rsClient *resultstore.Client, project string, build gcs.Build, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+	result, err := publisher.Read(ctx, client, build)
+	if err != nil {
+		return fmt.Errorf("read: %w", err)
+	}
+	up := publisher.Convert(project, build, *result)
+	log := logrus.WithFields(logrus.Fields{
+		"build":      build.Path.String(),
+		"invocation": up.ID,
+		"status":     up.Invocation.Status,
+		"tests":      len(up.Tests),
+	})
+	if rsClient == nil {
+		log.Info("Skipping upload")
+		return nil
+	}
+	rs := *rsClient
+	rs.WithContext(ctx)
+	if err := publisher.Publish(&rs, up); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+	log.WithField("url", resultstore.URL("invocations/"+up.ID)).Info("Published build")
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func newPathOrDie(s string) *gcs.Path {
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func TestGatherFlagOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		expected func(*options)
+		err      bool
+	}{
+		{
+			name: "builds are required",
+			args: []string{"--project=foo"},
+			err:  true,
+		},
+		{
+			name: "project is required",
+			args: []string{"gs://bucket/logs/job/1/"},
+			err:  true,
+		},
+		{
+			name: "basically works",
+			args: []string{"--project=foo", "gs://bucket/logs/job/1/", "gs://bucket/logs/job/2"},
+			expected: func(o *options) {
+				o.project = "foo"
+				o.builds = []gcs.Path{
+					*newPathOrDie("gs://bucket/logs/job/1/"),
+					*newPathOrDie("gs://bucket/logs/job/2/"),
+				}
+			},
+		},
+		{
+			name: "confirm requires a secret",
+			args: []string{"--project=foo", "--confirm", "gs://bucket/logs/job/1/"},
+			err:  true,
+		},
+		{
+			name: "confirm with a secret",
+			args: []string{"--project=foo", "--confirm", "--secret-file=/path/to/secret", "gs://bucket/logs/job/1/"},
+			expected: func(o *options) {
+				o.project = "foo"
+				o.confirm = true
+				o.secretFile = "/path/to/secret"
+				o.builds = []gcs.Path{*newPathOrDie("gs://bucket/logs/job/1/")}
+			},
+		},
+		{
+			name: "s3 builds require an endpoint",
+			args: []string{"--project=foo", "s3://bucket/logs/job/1/"},
+			err:  true,
+		},
+		{
+			name: "s3 builds with an endpoint",
+			args: []string{"--project=foo", "--s3-endpoint=http://localhost:9000", "s3://bucket/logs/job/1/"},
+			expected: func(o *options) {
+				o.project = "foo"
+				o.s3Endpoint = "http://localhost:9000"
+				o.builds = []gcs.Path{*newPathOrDie("s3://bucket/logs/job/1/")}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := options{
+				buildTimeout: 3 * time.Minute,
+			}
+			if tc.expected != nil {
+				tc.expected(&expected)
+			}
+			actual, err := gatherFlagOptions(flag.NewFlagSet(tc.name, flag.ContinueOnError), tc.args...)
+			if err == nil {
+				err = actual.validate()
+			}
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("validate() got an unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("validate() failed to return an error")
+			default:
+				if diff := cmp.Diff(actual, expected, cmp.AllowUnexported(options{}, gcs.Path{})); diff != "" {
+					t.Fatalf("gatherFlagOptions() got unexpected diff (-have, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
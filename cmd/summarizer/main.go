@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -31,8 +33,10 @@ import (
 )
 
 type options struct {
-	config            gcs.Path // gcs://path/to/config/proto
+	config            gcs.Path // gs://path/to/config/proto, s3://path/to/config/proto or file:///path/to/config/proto
 	creds             string
+	s3Endpoint        string
+	s3Region          string
 	confirm           bool
 	dashboard         string
 	concurrency       int
@@ -45,6 +49,9 @@ func (o *options) validate() error {
 	if o.config.String() == "" {
 		return errors.New("empty --config")
 	}
+	if strings.HasPrefix(o.config.String(), "s3://") && o.s3Endpoint == "" {
+		return fmt.Errorf("--config=%s: requires --s3-endpoint", o.config)
+	}
 	if o.concurrency == 0 {
 		o.concurrency = 4 * runtime.NumCPU()
 	}
@@ -53,8 +60,10 @@ func (o *options) validate() error {
 
 func gatherOptions() options {
 	var o options
-	flag.Var(&o.config, "config", "gs://path/to/config.pb")
+	flag.Var(&o.config, "config", "gs://path/to/config.pb, s3://path/to/config.pb or file:///path/to/config.pb")
 	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.s3Endpoint, "s3-endpoint", "", "Read and write s3:// paths from this S3-compatible endpoint, signing requests with $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY when set")
+	flag.StringVar(&o.s3Region, "s3-region", "", "Sign S3 requests for this region (us-east-1 if empty)")
 	flag.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
 	flag.StringVar(&o.dashboard, "dashboard", "", "Only update named dashboard if set")
 	flag.IntVar(&o.concurrency, "concurrency", 0, "Manually define the number of dashboards to concurrently update if non-zero")
@@ -78,10 +87,16 @@ func main() {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	client, err := gcs.ClientWithCreds(ctx, opt.creds)
+	client, release, err := gcs.NewDefaultClient(ctx, gcs.ClientOptions{
+		Creds:      opt.creds,
+		RequireGCS: strings.HasPrefix(opt.config.String(), "gs://"),
+		S3Endpoint: opt.s3Endpoint,
+		S3Region:   opt.s3Region,
+	})
 	if err != nil {
-		logrus.Fatalf("Failed to read storage client: %v", err)
+		logrus.Fatalf("Failed to create storage client: %v", err)
 	}
+	defer release()
 
 	updateOnce := func(ctx context.Context) error {
 		ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
@@ -21,20 +21,29 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 
+	_ "github.com/GoogleCloudPlatform/testgrid/metadata/test2json" // read test2json report_formats
+	updaterpb "github.com/GoogleCloudPlatform/testgrid/pb/updater"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 // options configures the updater
 type options struct {
-	config           gcs.Path // gs://path/to/config/proto
+	config           gcs.Path // gs://path/to/config/proto, s3://path/to/config/proto or file:///path/to/config/proto
 	creds            string
+	resultstore      bool
 	confirm          bool
 	debug            bool
 	trace            bool
@@ -46,6 +55,20 @@ type options struct {
 	buildTimeout     time.Duration
 	gridPrefix       string
 	jsonLogs         bool
+	s3Endpoint       string
+	s3Region         string
+	grpcListen       string
+	grpcTokenFile    string
+	pushListen       string
+	pushTokenFile    string
+	eventQueue       string
+	debounce         time.Duration
+	configRefresh    time.Duration
+}
+
+// events returns true when updating groups as their builds finish instead of on a timer.
+func (o *options) events() bool {
+	return o.pushListen != "" || o.eventQueue != ""
 }
 
 // validate ensures sane options
@@ -56,6 +79,21 @@ func (o *options) validate() error {
 	if o.config.Bucket() == "k8s-testgrid" && o.gridPrefix == "" && o.confirm {
 		return fmt.Errorf("--config=%s: cannot write grid state to gs://k8s-testgrid", o.config)
 	}
+	if strings.HasPrefix(o.config.String(), "s3://") && o.s3Endpoint == "" {
+		return fmt.Errorf("--config=%s: requires --s3-endpoint", o.config)
+	}
+	if o.grpcListen != "" && o.confirm && o.grpcTokenFile == "" {
+		return errors.New("--grpc-listen with --confirm requires --grpc-token-file")
+	}
+	if o.pushListen != "" && o.pushTokenFile == "" {
+		return errors.New("--push-listen requires --push-token-file")
+	}
+	if o.events() && o.wait != 0 {
+		return errors.New("--wait is incompatible with --push-listen and --event-queue")
+	}
+	if o.events() && o.group != "" {
+		return errors.New("--test-group is incompatible with --push-listen and --event-queue")
+	}
 	if o.groupConcurrency == 0 {
 		o.groupConcurrency = runtime.NumCPU()
 	}
@@ -66,11 +104,17 @@ func (o *options) validate() error {
 	return nil
 }
 
+// local returns true when the config is not in GCS, so GCS credentials are optional.
+func (o *options) local() bool {
+	return !strings.HasPrefix(o.config.String(), "gs://")
+}
+
 // gatherOptions reads options from flags
 func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
 	var o options
-	fs.Var(&o.config, "config", "gs://path/to/config.pb")
+	fs.Var(&o.config, "config", "gs://path/to/config.pb, s3://path/to/config.pb or file:///path/to/config.pb")
 	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	fs.BoolVar(&o.resultstore, "resultstore", false, "Read groups with a resultstore_config from ResultStore, authenticating with --gcp-service-account, if set")
 	fs.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
 	fs.BoolVar(&o.debug, "debug", false, "Log debug lines if set")
 	fs.BoolVar(&o.trace, "trace", false, "Log trace and debug lines if set")
@@ -82,6 +126,15 @@ func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
 	fs.DurationVar(&o.buildTimeout, "build-timeout", 3*time.Minute, "Maximum time to wait to read each build")
 	fs.StringVar(&o.gridPrefix, "grid-prefix", "grid", "Join this with the grid name to create the GCS suffix")
 	fs.BoolVar(&o.jsonLogs, "json-logs", false, "Uses a json logrus formatter when set")
+	fs.StringVar(&o.s3Endpoint, "s3-endpoint", "", "Read and write s3:// paths from this S3-compatible endpoint, signing requests with $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY when set")
+	fs.StringVar(&o.s3Region, "s3-region", "", "Sign S3 requests for this region (us-east-1 if empty)")
+	fs.StringVar(&o.grpcListen, "grpc-listen", "", "Serve on-demand updates over gRPC at this address (such as :9090) if set")
+	fs.StringVar(&o.grpcTokenFile, "grpc-token-file", "", "/path/to/file holding the bearer token gRPC updates must send when --confirm is set")
+	fs.StringVar(&o.pushListen, "push-listen", "", "Update groups as Pub/Sub pushes object notifications to this address (such as :8080) if set")
+	fs.StringVar(&o.pushTokenFile, "push-token-file", "", "/path/to/file holding the token push notifications must send as a token query parameter or bearer token")
+	fs.StringVar(&o.eventQueue, "event-queue", "", "Update groups as files listing finished.json urls are added to this directory if set")
+	fs.DurationVar(&o.debounce, "debounce", time.Minute, "Wait this long after a build finishes before updating its groups")
+	fs.DurationVar(&o.configRefresh, "config-refresh", 10*time.Minute, "Reload the config this often when updating on events")
 	fs.Parse(args)
 	return o
 }
@@ -114,13 +167,26 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	storageClient, err := gcs.ClientWithCreds(ctx, opt.creds)
+	client, release, err := gcs.NewDefaultClient(ctx, gcs.ClientOptions{
+		Creds:      opt.creds,
+		RequireGCS: !opt.local(),
+		S3Endpoint: opt.s3Endpoint,
+		S3Region:   opt.s3Region,
+	})
 	if err != nil {
 		logrus.Fatalf("Failed to create storage client: %v", err)
 	}
-	defer storageClient.Close()
+	defer release()
 
-	client := gcs.NewClient(storageClient)
+	var rsClient *resultstore.Client
+	if opt.resultstore {
+		conn, err := resultstore.Connect(ctx, opt.creds)
+		if err != nil {
+			logrus.Fatalf("Failed to connect to ResultStore: %v", err)
+		}
+		defer conn.Close()
+		rsClient = resultstore.NewClient(conn)
+	}
 
 	logrus.WithFields(logrus.Fields{
 		"group": opt.groupConcurrency,
@@ -129,14 +195,43 @@ func main() {
 
 	updateOnce := func() {
 		start := time.Now()
-		if err := updater.Update(client, ctx, opt.config, opt.gridPrefix, opt.groupConcurrency, opt.buildConcurrency, opt.confirm, opt.groupTimeout, opt.buildTimeout, opt.group); err != nil {
+		if err := updater.Update(ctx, client, rsClient, opt.config, opt.gridPrefix, opt.groupConcurrency, opt.buildConcurrency, opt.confirm, opt.groupTimeout, opt.buildTimeout, opt.group); err != nil {
 			logrus.WithError(err).Error("Could not update")
 		}
 		logrus.Infof("Update completed in %s", time.Since(start))
 	}
 
+	if opt.grpcListen != "" {
+		lis, err := net.Listen("tcp", opt.grpcListen)
+		if err != nil {
+			logrus.Fatalf("Failed to listen on %s: %v", opt.grpcListen, err)
+		}
+		var token string
+		if opt.grpcTokenFile != "" {
+			if token, err = readToken(opt.grpcTokenFile); err != nil {
+				logrus.Fatalf("Failed to read --grpc-token-file: %v", err)
+			}
+		}
+		srv := grpc.NewServer()
+		updaterpb.RegisterUpdaterServer(srv, updater.NewServer(client, rsClient, opt.config, opt.gridPrefix, opt.buildConcurrency, opt.confirm, token, opt.groupTimeout, opt.buildTimeout))
+		go func() {
+			logrus.WithField("listen", opt.grpcListen).Info("Serving gRPC updates")
+			if err := srv.Serve(lis); err != nil {
+				logrus.Fatalf("Failed to serve gRPC: %v", err)
+			}
+		}()
+		defer srv.GracefulStop()
+	}
+
 	updateOnce()
+	if opt.events() {
+		updateOnEvents(ctx, client, rsClient, opt)
+		return
+	}
 	if opt.wait == 0 {
+		if opt.grpcListen != "" {
+			<-ctx.Done() // Serve until killed.
+		}
 		return
 	}
 	timer := time.NewTimer(opt.wait)
@@ -147,3 +242,44 @@ func main() {
 		logrus.WithField("wait", opt.wait).Info("Sleeping...")
 	}
 }
+
+// readToken returns the token in the file, without surrounding whitespace.
+func readToken(path string) (string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+// queuePoll is how often to look for new files in the --event-queue.
+const queuePoll = 10 * time.Second
+
+// updateOnEvents updates groups as notifications arrive from the push endpoint or event queue.
+func updateOnEvents(ctx context.Context, client gcs.Client, rsClient *resultstore.Client, opt options) {
+	events := make(chan gcs.Path)
+	if opt.pushListen != "" {
+		token, err := readToken(opt.pushTokenFile)
+		if err != nil {
+			logrus.Fatalf("Failed to read --push-token-file: %v", err)
+		}
+		go func() {
+			logrus.WithField("listen", opt.pushListen).Info("Accepting push notifications")
+			if err := http.ListenAndServe(opt.pushListen, updater.PushHandler(events, token)); err != nil {
+				logrus.Fatalf("Failed to serve push notifications: %v", err)
+			}
+		}()
+	}
+	if opt.eventQueue != "" {
+		go func() {
+			logrus.WithField("queue", opt.eventQueue).Info("Watching event queue")
+			if err := updater.WatchQueue(ctx, opt.eventQueue, queuePoll, events); err != nil && err != context.Canceled {
+				logrus.Fatalf("Failed to watch event queue: %v", err)
+			}
+		}()
+	}
+	err := updater.UpdateOnEvents(ctx, client, rsClient, opt.config, opt.gridPrefix, opt.groupConcurrency, opt.buildConcurrency, opt.confirm, opt.groupTimeout, opt.buildTimeout, opt.debounce, opt.configRefresh, events)
+	if err != nil && err != context.Canceled {
+		logrus.WithError(err).Error("Could not update on events")
+	}
+}
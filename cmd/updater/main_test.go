@@ -110,6 +110,103 @@ func TestGatherFlagOptions(t *testing.T) {
 				o.confirm = true
 			},
 		},
+		{
+			name: "allow --config=s3://bucket/config --s3-endpoint",
+			args: []string{
+				"--config=s3://bucket/config",
+				"--s3-endpoint=http://localhost:9000",
+			},
+			expected: func(o *options) {
+				o.config = *newPathOrDie("s3://bucket/config")
+				o.s3Endpoint = "http://localhost:9000"
+			},
+		},
+		{
+			name: "reject --config=s3://bucket/config without --s3-endpoint",
+			args: []string{
+				"--config=s3://bucket/config",
+			},
+			err: true,
+		},
+		{
+			name: "allow --config=file:///path/to/config",
+			args: []string{
+				"--config=file:///path/to/config",
+				"--confirm",
+			},
+			expected: func(o *options) {
+				o.config = *newPathOrDie("file:///path/to/config")
+				o.confirm = true
+			},
+		},
+		{
+			name: "allow --push-listen and --event-queue",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--push-listen=:8080",
+				"--push-token-file=/path/to/token",
+				"--event-queue=/path/to/queue",
+				"--debounce=5m",
+			},
+			expected: func(o *options) {
+				o.config = *newPathOrDie("gs://bucket/config")
+				o.pushListen = ":8080"
+				o.pushTokenFile = "/path/to/token"
+				o.eventQueue = "/path/to/queue"
+				o.debounce = 5 * time.Minute
+			},
+		},
+		{
+			name: "allow --grpc-listen with --confirm and --grpc-token-file",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--grpc-listen=:9090",
+				"--grpc-token-file=/path/to/token",
+				"--confirm",
+			},
+			expected: func(o *options) {
+				o.config = *newPathOrDie("gs://bucket/config")
+				o.grpcListen = ":9090"
+				o.grpcTokenFile = "/path/to/token"
+				o.confirm = true
+			},
+		},
+		{
+			name: "reject --grpc-listen with --confirm but no --grpc-token-file",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--grpc-listen=:9090",
+				"--confirm",
+			},
+			err: true,
+		},
+		{
+			name: "reject --push-listen without --push-token-file",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--push-listen=:8080",
+			},
+			err: true,
+		},
+		{
+			name: "reject --push-listen with --wait",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--push-listen=:8080",
+				"--push-token-file=/path/to/token",
+				"--wait=10m",
+			},
+			err: true,
+		},
+		{
+			name: "reject --event-queue with --test-group",
+			args: []string{
+				"--config=gs://bucket/config",
+				"--event-queue=/path/to/queue",
+				"--test-group=foo",
+			},
+			err: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -120,6 +217,8 @@ func TestGatherFlagOptions(t *testing.T) {
 				groupConcurrency: runtime.NumCPU(),
 				groupTimeout:     10 * time.Minute,
 				gridPrefix:       "grid",
+				debounce:         time.Minute,
+				configRefresh:    10 * time.Minute,
 			}
 			if tc.expected != nil {
 				tc.expected(&expected)
@@ -134,7 +233,7 @@ func TestGatherFlagOptions(t *testing.T) {
 				t.Error("validate() failed to return an error")
 			default:
 				if diff := cmp.Diff(actual, expected, cmp.AllowUnexported(options{}, gcs.Path{})); diff != "" {
-					t.Fatal("gatherFlagOptions() got unexpected diff (-have, +want):\n%s", diff)
+					t.Fatalf("gatherFlagOptions() got unexpected diff (-have, +want):\n%s", diff)
 				}
 
 			}
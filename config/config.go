@@ -29,7 +29,10 @@ import (
 	"cloud.google.com/go/storage"
 	"github.com/golang/protobuf/proto"
 
+	_ "github.com/GoogleCloudPlatform/testgrid/metadata/test2json" // validate test2json report_formats
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 	multierror "github.com/hashicorp/go-multierror"
 )
@@ -249,7 +252,11 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 		return multierror.Append(mErr, errors.New("got an empty TestGroup"))
 	}
 	// Check that required fields are a non-zero-value.
-	if tg.GetGcsPrefix() == "" {
+	if rs := tg.GetResultSource().GetResultstoreConfig(); rs != nil {
+		if rs.GetProject() == "" {
+			mErr = multierror.Append(mErr, errors.New("resultstore_config.project can't be empty"))
+		}
+	} else if tg.GetGcsPrefix() == "" {
 		mErr = multierror.Append(mErr, errors.New("gcs_prefix can't be empty"))
 	}
 	if tg.GetDaysOfResults() <= 0 {
@@ -258,6 +265,12 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 	if tg.GetNumColumnsRecent() <= 0 {
 		mErr = multierror.Append(mErr, errors.New("num_columns_recent should be positive"))
 	}
+	if tg.GetMaxColumnsPerUpdate() < 0 {
+		mErr = multierror.Append(mErr, errors.New("max_columns_per_update should not be negative"))
+	}
+	if _, err := gcs.LookupFormats(tg.GetReportFormats()...); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("report_formats: %v", err))
+	}
 
 	// Regexes should be valid.
 	if _, err := regexp.Compile(tg.GetCommitOverrideLabelPattern()); err != nil {
@@ -302,7 +315,7 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 		if annotation.GetPropertyName() == "" {
 			mErr = multierror.Append(mErr, errors.New("property_name is required"))
 		}
-		if annotation.GetShortText() == "" || len(annotation.GetShortText()) >= 5 {
+		if annotation.GetShortText() == "" || len(annotation.GetShortText()) > 5 {
 			mErr = multierror.Append(mErr, errors.New("short_text must be 1-5 characters long"))
 		}
 	}
@@ -329,6 +342,13 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 
 	}
 
+	// Custom evaluator rules must compute a status and compare valid values.
+	for idx, rule := range tg.GetCustomEvaluatorRuleSet().GetRules() {
+		if err := validateRule(rule); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("custom_evaluator_rule_set rule %d: %v", idx, err))
+		}
+	}
+
 	// test_name_config should have a matching number of format strings and name elements.
 	if tg.GetTestNameConfig() != nil {
 		nameFormat := tg.GetTestNameConfig().GetNameFormat()
@@ -361,6 +381,34 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 	return mErr
 }
 
+// validateRule checks that a custom evaluator rule is complete and its regexes compile.
+func validateRule(rule *evalpb.Rule) error {
+	var mErr error
+	if rule.GetComputedStatus() == statuspb.TestStatus_NO_RESULT {
+		mErr = multierror.Append(mErr, errors.New("computed_status is required"))
+	}
+	if len(rule.GetTestResultComparisons()) == 0 {
+		mErr = multierror.Append(mErr, errors.New("test_result_comparisons can't be empty"))
+	}
+	for idx, trc := range rule.GetTestResultComparisons() {
+		if trc.GetTestResultInfo() == nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("comparison %d: one of property_key, test_result_field or test_result_error_field is required", idx))
+		}
+		cmp := trc.GetComparison()
+		if cmp == nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("comparison %d: comparison is required", idx))
+			continue
+		}
+		if cmp.GetOp() != evalpb.Comparison_OP_REGEX {
+			continue
+		}
+		if _, err := regexp.Compile(cmp.GetStringValue()); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("comparison %d: OP_REGEX value doesn't compile: %v", idx, err))
+		}
+	}
+	return mErr
+}
+
 func validateDashboardTab(dt *configpb.DashboardTab) error {
 	var mErr error
 	if dt == nil {
@@ -557,3 +605,13 @@ func FindDashboard(name string, cfg *configpb.Configuration) *configpb.Dashboard
 	}
 	return nil
 }
+
+// FindDashboardTab returns the configpb.DashboardTab proto for a given Dashboard and tab name.
+func FindDashboardTab(dashboard, tab string, cfg *configpb.Configuration) *configpb.DashboardTab {
+	for _, t := range FindDashboard(dashboard, cfg).GetDashboardTab() {
+		if t.GetName() == tab {
+			return t
+		}
+	}
+	return nil
+}
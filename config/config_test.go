@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	multierror "github.com/hashicorp/go-multierror"
 )
 
@@ -466,6 +468,55 @@ func TestValidateTestGroup(t *testing.T) {
 				NumColumnsRecent: -1,
 			},
 		},
+		{
+			name: "resultstore_config does not need gcs_prefix",
+			pass: true,
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_ResultstoreConfig{
+						ResultstoreConfig: &configpb.ResultStoreConfig{
+							Project: "project",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "resultstore_config must have a project",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_ResultstoreConfig{
+						ResultstoreConfig: &configpb.ResultStoreConfig{},
+					},
+				},
+			},
+		},
+		{
+			name: "max_columns_per_update must not be negative",
+			testGroup: &configpb.TestGroup{
+				Name:                "test_group",
+				DaysOfResults:       1,
+				GcsPrefix:           "fake path",
+				NumColumnsRecent:    1,
+				MaxColumnsPerUpdate: -1,
+			},
+		},
+		{
+			name: "report_formats must be registered",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				ReportFormats:    []string{"junit", "unknown"},
+			},
+		},
 		{
 			name: "commit_override_label_pattern must compile",
 			testGroup: &configpb.TestGroup{
@@ -546,6 +597,24 @@ func TestValidateTestGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test annotation short_text may have 5 characters",
+			pass: true,
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				TestAnnotations: []*configpb.TestGroup_TestAnnotation{
+					{
+						ShortTextMessageSource: &configpb.TestGroup_TestAnnotation_PropertyName{
+							PropertyName: "something",
+						},
+						ShortText: "abcde",
+					},
+				},
+			},
+		},
 		{
 			name: "fallback_grouping_configuration_value requires fallback_group = configuration_value",
 			testGroup: &configpb.TestGroup{
@@ -709,6 +778,109 @@ func TestValidateTestGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "custom evaluator rules pass",
+			pass: true,
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				CustomEvaluatorRuleSet: &evalpb.RuleSet{
+					Rules: []*evalpb.Rule{
+						{
+							ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+							TestResultComparisons: []*evalpb.TestResultComparison{
+								{
+									TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+										PropertyKey: "node",
+									},
+									Comparison: &evalpb.Comparison{
+										Op: evalpb.Comparison_OP_REGEX,
+										ComparisonValue: &evalpb.Comparison_StringValue{
+											StringValue: "bad-.*",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom evaluator rules require computed_status",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				CustomEvaluatorRuleSet: &evalpb.RuleSet{
+					Rules: []*evalpb.Rule{
+						{
+							TestResultComparisons: []*evalpb.TestResultComparison{
+								{
+									TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+										PropertyKey: "node",
+									},
+									Comparison: &evalpb.Comparison{
+										Op: evalpb.Comparison_OP_EQ,
+										ComparisonValue: &evalpb.Comparison_StringValue{
+											StringValue: "bad",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom evaluator rules require comparisons",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				CustomEvaluatorRuleSet: &evalpb.RuleSet{
+					Rules: []*evalpb.Rule{
+						{
+							ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom evaluator regex must compile",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				CustomEvaluatorRuleSet: &evalpb.RuleSet{
+					Rules: []*evalpb.Rule{
+						{
+							ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+							TestResultComparisons: []*evalpb.TestResultComparison{
+								{
+									TestResultInfo: &evalpb.TestResultComparison_TestResultField{
+										TestResultField: "name",
+									},
+									Comparison: &evalpb.Comparison{
+										Op: evalpb.Comparison_OP_REGEX,
+										ComparisonValue: &evalpb.Comparison_StringValue{
+											StringValue: "[.*",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reject unbalanced name formats",
 			testGroup: &configpb.TestGroup{
@@ -1088,3 +1260,57 @@ func TestUpdate_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestFindDashboardTab(t *testing.T) {
+	cfg := &configpb.Configuration{
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{Name: "tab", TestGroupName: "group"},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		cfg       *configpb.Configuration
+		dashboard string
+		tab       string
+		expected  string
+	}{
+		{
+			name:      "finds the tab",
+			cfg:       cfg,
+			dashboard: "dash",
+			tab:       "tab",
+			expected:  "group",
+		},
+		{
+			name:      "missing tab",
+			cfg:       cfg,
+			dashboard: "dash",
+			tab:       "other",
+		},
+		{
+			name:      "missing dashboard",
+			cfg:       cfg,
+			dashboard: "other",
+			tab:       "tab",
+		},
+		{
+			name:      "nil config",
+			dashboard: "dash",
+			tab:       "tab",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := FindDashboardTab(test.dashboard, test.tab, test.cfg).GetTestGroupName()
+			if actual != test.expected {
+				t.Errorf("FindDashboardTab() got tab for %q, want %q", actual, test.expected)
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ type Started struct {
 	Timestamp int64 `json:"timestamp"` // epoch seconds
 	// Node holds the name of the machine that ran the job.
 	Node string `json:"node,omitempty"`
+	// Labels holds key/value pairs describing the job, such as the prow job labels.
+	Labels map[string]string `json:"labels,omitempty"`
 
 	// Consider whether to keep the following:
 
@@ -62,6 +64,8 @@ type Finished struct {
 	// For example, the version of a binary downloaded at runtime
 	// The JobVersion key overrides the auto-version set in Started.
 	Metadata Metadata `json:"metadata,omitempty"`
+	// Labels holds key/value pairs computed by the job, overriding Started labels.
+	Labels map[string]string `json:"labels,omitempty"`
 
 	// Consider whether to keep the following:
 
@@ -40,9 +40,8 @@ type Suite struct {
 	Failures int      `xml:"failures,attr"`
 	Tests    int      `xml:"tests,attr"`
 	Results  []Result `xml:"testcase"`
-	/*
-	* <properties><property name="go.version" value="go1.8.3"/></properties>
-	 */
+	// <properties><property name="go.version" value="go1.8.3"/></properties>
+	Properties *Properties `xml:"properties,omitempty"`
 }
 
 // Property defines the xml element that stores additional metrics about each benchmark.
@@ -91,6 +91,33 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "parse suite properties",
+			buf: []byte(`
+                        <testsuite name="props">
+                            <properties>
+                                <property name="go.version" value="go1.8.3"/>
+                            </properties>
+                            <testcase name="case" />
+                        </testsuite>
+                        `),
+			expected: &Suites{
+				Suites: []Suite{
+					{
+						XMLName: xml.Name{Local: "testsuite"},
+						Name:    "props",
+						Properties: &Properties{
+							PropertyList: []Property{
+								{Name: "go.version", Value: "go1.8.3"},
+							},
+						},
+						Results: []Result{
+							{Name: "case"},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
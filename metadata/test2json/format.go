@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test2json
+
+import (
+	"path"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// FormatName is the name of the go test -json report format.
+const FormatName = "test2json"
+
+func init() {
+	if err := gcs.RegisterFormat(gcs.Format{
+		Name:  FormatName,
+		Match: match,
+		Parse: Parse,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// match returns metadata for test2json*.json artifacts, such as test2json_unit.json.
+//
+// The Context is the name of the file, without the extension.
+func match(name string) map[string]string {
+	base := path.Base(name)
+	if !strings.HasPrefix(base, FormatName) || !strings.HasSuffix(base, ".json") {
+		return nil
+	}
+	return map[string]string{
+		"Context":   strings.TrimSuffix(base, ".json"),
+		"Timestamp": "",
+		"Thread":    "",
+	}
+}
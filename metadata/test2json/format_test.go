@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test2json
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		name     string
+		artifact string
+		expected map[string]string
+	}{
+		{
+			name:     "basically works",
+			artifact: "artifacts/test2json_unit.json",
+			expected: map[string]string{
+				"Context":   "test2json_unit",
+				"Timestamp": "",
+				"Thread":    "",
+			},
+		},
+		{
+			name:     "ignore other json",
+			artifact: "artifacts/report.json",
+		},
+		{
+			name:     "ignore other extensions",
+			artifact: "artifacts/test2json.log",
+		},
+		{
+			name:     "ignore other artifacts",
+			artifact: "artifacts/build-log.txt",
+		},
+		{
+			name:     "ignore build metadata",
+			artifact: "logs/job/123/finished.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, match(tc.artifact)); diff != "" {
+				t.Errorf("match(%q) got unexpected diff (-want +got):\n%s", tc.artifact, diff)
+			}
+		})
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	formats, err := gcs.LookupFormats(FormatName)
+	if err != nil {
+		t.Fatalf("LookupFormats(%q) got unexpected error: %v", FormatName, err)
+	}
+	if name := formats[0].Name; name != FormatName {
+		t.Errorf("LookupFormats(%q) got %q", FormatName, name)
+	}
+}
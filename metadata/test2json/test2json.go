@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package test2json converts the output of go test -json into junit results.
+package test2json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+// Event holds a line of go test -json output.
+//
+// See https://golang.org/cmd/test2json
+type Event struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64 // Seconds
+	Output  string
+
+	// Newer versions of go report build output separately.
+	ImportPath  string
+	FailedBuild string
+}
+
+// Test2json actions
+const (
+	actionBuildOutput = "build-output"
+	actionFail        = "fail"
+	actionOutput      = "output"
+	actionPass        = "pass"
+	actionSkip        = "skip"
+)
+
+// test accumulates the events of a test.
+type test struct {
+	name    string
+	action  string
+	elapsed float64
+	output  []string
+}
+
+// pkg accumulates the events of a package.
+type pkg struct {
+	test
+	tests   []*test
+	indices map[string]int
+}
+
+// get returns the named test, adding it if necessary.
+func (p *pkg) get(name string) *test {
+	if idx, ok := p.indices[name]; ok {
+		return p.tests[idx]
+	}
+	t := &test{name: name}
+	p.indices[name] = len(p.tests)
+	p.tests = append(p.tests, t)
+	return t
+}
+
+// Parse converts go test -json output into suites.
+//
+// Each package becomes a suite, holding a result for each of its tests.
+// Subtests retain their Parent/sub names. Failing and skipped tests include
+// their output.
+//
+// Packages that fail without a failing test, such as when the package does not
+// build, include a nameless failing result with the package output.
+//
+// Ignores lines that are not test2json events, such as build errors from older
+// versions of go, but returns an error when no line is an event.
+func Parse(buf []byte) (*junit.Suites, error) {
+	var pkgs []*pkg
+	pkgIndices := map[string]int{}
+	buildOutput := map[string][]string{}
+	get := func(name string) *pkg {
+		if idx, ok := pkgIndices[name]; ok {
+			return pkgs[idx]
+		}
+		p := &pkg{
+			test:    test{name: name},
+			indices: map[string]int{},
+		}
+		pkgIndices[name] = len(pkgs)
+		pkgs = append(pkgs, p)
+		return p
+	}
+
+	var events int
+	for _, line := range bytes.Split(buf, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var ev Event
+		if err := json.Unmarshal(line, &ev); err != nil {
+			continue
+		}
+		if ev.Action == "" {
+			continue
+		}
+		events++
+		if ev.Action == actionBuildOutput {
+			buildOutput[ev.ImportPath] = append(buildOutput[ev.ImportPath], ev.Output)
+			continue
+		}
+		if ev.Package == "" {
+			continue
+		}
+		p := get(ev.Package)
+		t := &p.test
+		if ev.Test != "" {
+			t = p.get(ev.Test)
+		}
+		switch ev.Action {
+		case actionOutput:
+			t.output = append(t.output, ev.Output)
+		case actionPass, actionFail, actionSkip:
+			t.action = ev.Action
+			t.elapsed = ev.Elapsed
+			if ev.Test == "" && ev.FailedBuild != "" {
+				build := buildOutput[ev.FailedBuild]
+				t.output = append(build[:len(build):len(build)], t.output...)
+			}
+		}
+	}
+
+	if events == 0 {
+		return nil, errors.New("no test2json events")
+	}
+
+	var suites junit.Suites
+	for _, p := range pkgs {
+		suites.Suites = append(suites.Suites, p.suite())
+	}
+	return &suites, nil
+}
+
+// suite converts the package into a junit suite.
+func (p pkg) suite() junit.Suite {
+	suite := junit.Suite{
+		Name: p.name,
+		Time: p.elapsed,
+	}
+	for _, t := range p.tests {
+		r := junit.Result{
+			Name: t.name,
+			Time: t.elapsed,
+		}
+		switch t.action {
+		case actionPass:
+		case actionSkip:
+			msg := message(t.output)
+			r.Skipped = &msg
+		case actionFail:
+			msg := message(t.output)
+			r.Failure = &msg
+		default: // The package crashed or timed out before the test finished.
+			msg := message(t.output)
+			if msg == "" {
+				msg = "Test did not finish"
+			}
+			r.Failure = &msg
+		}
+		if r.Failure != nil {
+			suite.Failures++
+		}
+		suite.Tests++
+		suite.Results = append(suite.Results, r)
+	}
+	if p.action != actionPass && p.action != actionSkip && suite.Failures == 0 {
+		msg := message(p.output)
+		if msg == "" {
+			msg = "Package failed"
+		}
+		suite.Results = append(suite.Results, junit.Result{
+			Time:    p.elapsed,
+			Failure: &msg,
+		})
+		suite.Failures++
+		suite.Tests++
+	}
+	return suite
+}
+
+// message joins the output, dropping the === RUN and --- FAIL lines that frame each test.
+func message(output []string) string {
+	var sb strings.Builder
+	for _, line := range output {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "=== ") || strings.HasPrefix(trimmed, "--- ") {
+			continue
+		}
+		sb.WriteString(line)
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test2json
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+func TestParse(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	cases := []struct {
+		name     string
+		lines    []string
+		expected junit.Suites
+		err      bool
+	}{
+		{
+			name: "reject empty output",
+			err:  true,
+		},
+		{
+			name: "passing tests",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo","Output":"=== RUN   TestFoo\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo","Output":"    foo_test.go:10: hello\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo","Output":"--- PASS: TestFoo (0.50s)\n"}`,
+				`{"Action":"pass","Package":"example.com/foo","Test":"TestFoo","Elapsed":0.5}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"PASS\n"}`,
+				`{"Action":"pass","Package":"example.com/foo","Elapsed":0.7}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:  "example.com/foo",
+						Time:  0.7,
+						Tests: 1,
+						Results: []junit.Result{
+							{
+								Name: "TestFoo",
+								Time: 0.5,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failing subtests",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo/bar"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo/bar","Output":"=== RUN   TestFoo/bar\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo/bar","Output":"    foo_test.go:12: boom\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo/bar","Output":"    --- FAIL: TestFoo/bar (0.10s)\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Test":"TestFoo/bar","Elapsed":0.1}`,
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo/baz"}`,
+				`{"Action":"pass","Package":"example.com/foo","Test":"TestFoo/baz","Elapsed":0.2}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo","Output":"--- FAIL: TestFoo (0.30s)\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Test":"TestFoo","Elapsed":0.3}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"FAIL\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Elapsed":0.4}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Time:     0.4,
+						Tests:    3,
+						Failures: 2,
+						Results: []junit.Result{
+							{
+								Name:    "TestFoo",
+								Time:    0.3,
+								Failure: pstr(""),
+							},
+							{
+								Name:    "TestFoo/bar",
+								Time:    0.1,
+								Failure: pstr("foo_test.go:12: boom"),
+							},
+							{
+								Name: "TestFoo/baz",
+								Time: 0.2,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "skipped tests",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestFoo","Output":"    foo_test.go:5: not ready\n"}`,
+				`{"Action":"skip","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"output","Package":"example.com/bar","Output":"?   \texample.com/bar\t[no test files]\n"}`,
+				`{"Action":"skip","Package":"example.com/bar"}`,
+				`{"Action":"pass","Package":"example.com/foo"}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:  "example.com/foo",
+						Tests: 1,
+						Results: []junit.Result{
+							{
+								Name:    "TestFoo",
+								Skipped: pstr("foo_test.go:5: not ready"),
+							},
+						},
+					},
+					{
+						Name: "example.com/bar",
+					},
+				},
+			},
+		},
+		{
+			name: "interleave packages",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"run","Package":"example.com/bar","Test":"TestBar"}`,
+				`{"Action":"pass","Package":"example.com/bar","Test":"TestBar"}`,
+				`{"Action":"pass","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"pass","Package":"example.com/bar"}`,
+				`{"Action":"pass","Package":"example.com/foo"}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:    "example.com/foo",
+						Tests:   1,
+						Results: []junit.Result{{Name: "TestFoo"}},
+					},
+					{
+						Name:    "example.com/bar",
+						Tests:   1,
+						Results: []junit.Result{{Name: "TestBar"}},
+					},
+				},
+			},
+		},
+		{
+			name: "unfinished tests fail",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"run","Package":"example.com/foo","Test":"TestSlow"}`,
+				`{"Action":"output","Package":"example.com/foo","Test":"TestSlow","Output":"    slow_test.go:8: waiting\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"panic: test timed out after 10m0s\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Elapsed":600}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Time:     600,
+						Tests:    2,
+						Failures: 2,
+						Results: []junit.Result{
+							{
+								Name:    "TestFoo",
+								Failure: pstr("Test did not finish"),
+							},
+							{
+								Name:    "TestSlow",
+								Failure: pstr("slow_test.go:8: waiting"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "package failures without failing tests",
+			lines: []string{
+				`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"pass","Package":"example.com/foo","Test":"TestFoo"}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"TestMain: cleanup failed\n"}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"FAIL\texample.com/foo\t0.1s\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Elapsed":0.1}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Time:     0.1,
+						Tests:    2,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Name: "TestFoo",
+							},
+							{
+								Time:    0.1,
+								Failure: pstr("TestMain: cleanup failed\nFAIL\texample.com/foo\t0.1s"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "build failures",
+			lines: []string{
+				`# example.com/foo`,
+				`foo.go:3:1: syntax error: non-declaration statement outside function body`,
+				`{"Action":"output","Package":"example.com/foo","Output":"FAIL\texample.com/foo [build failed]\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Elapsed":0}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Tests:    1,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Failure: pstr("FAIL\texample.com/foo [build failed]"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "build output events",
+			lines: []string{
+				`{"ImportPath":"example.com/foo [example.com/foo.test]","Action":"build-output","Output":"# example.com/foo\n"}`,
+				`{"ImportPath":"example.com/foo [example.com/foo.test]","Action":"build-output","Output":"foo.go:3:1: syntax error\n"}`,
+				`{"ImportPath":"example.com/foo [example.com/foo.test]","Action":"build-fail"}`,
+				`{"Action":"output","Package":"example.com/foo","Output":"FAIL\texample.com/foo [build failed]\n"}`,
+				`{"Action":"fail","Package":"example.com/foo","Elapsed":0,"FailedBuild":"example.com/foo [example.com/foo.test]"}`,
+			},
+			expected: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Tests:    1,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Failure: pstr("# example.com/foo\nfoo.go:3:1: syntax error\nFAIL\texample.com/foo [build failed]"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reject other json",
+			lines: []string{
+				`{"timestamp":1234,"passed":true}`,
+				`{`,
+				`  "kind": "ProwJob"`,
+				`}`,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Parse([]byte(strings.Join(tc.lines, "\n")))
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("Parse() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("Parse() failed to receive an error")
+			}
+			if diff := cmp.Diff(tc.expected, *actual); diff != "" {
+				t.Errorf("Parse() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+/*
+Copyright The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api.proto
+
+package api
+
+import (
+	fmt "fmt"
+	state "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	summary "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// A named resource and the API path to read it.
+type Resource struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link                 string   `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Resource) Reset()         { *m = Resource{} }
+func (m *Resource) String() string { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()    {}
+func (*Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *Resource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Resource.Unmarshal(m, b)
+}
+func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
+}
+func (m *Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resource.Merge(m, src)
+}
+func (m *Resource) XXX_Size() int {
+	return xxx_messageInfo_Resource.Size(m)
+}
+func (m *Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resource proto.InternalMessageInfo
+
+func (m *Resource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Resource) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+// The dashboard groups in the configuration.
+type ListDashboardGroupsResponse struct {
+	DashboardGroups      []*Resource `protobuf:"bytes,1,rep,name=dashboard_groups,json=dashboardGroups,proto3" json:"dashboard_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListDashboardGroupsResponse) Reset()         { *m = ListDashboardGroupsResponse{} }
+func (m *ListDashboardGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDashboardGroupsResponse) ProtoMessage()    {}
+func (*ListDashboardGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *ListDashboardGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDashboardGroupsResponse.Unmarshal(m, b)
+}
+func (m *ListDashboardGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDashboardGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDashboardGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDashboardGroupsResponse.Merge(m, src)
+}
+func (m *ListDashboardGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDashboardGroupsResponse.Size(m)
+}
+func (m *ListDashboardGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDashboardGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDashboardGroupsResponse proto.InternalMessageInfo
+
+func (m *ListDashboardGroupsResponse) GetDashboardGroups() []*Resource {
+	if m != nil {
+		return m.DashboardGroups
+	}
+	return nil
+}
+
+// The dashboards in the configuration or a dashboard group.
+type ListDashboardsResponse struct {
+	Dashboards           []*Resource `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListDashboardsResponse) Reset()         { *m = ListDashboardsResponse{} }
+func (m *ListDashboardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDashboardsResponse) ProtoMessage()    {}
+func (*ListDashboardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *ListDashboardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDashboardsResponse.Unmarshal(m, b)
+}
+func (m *ListDashboardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDashboardsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDashboardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDashboardsResponse.Merge(m, src)
+}
+func (m *ListDashboardsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDashboardsResponse.Size(m)
+}
+func (m *ListDashboardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDashboardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDashboardsResponse proto.InternalMessageInfo
+
+func (m *ListDashboardsResponse) GetDashboards() []*Resource {
+	if m != nil {
+		return m.Dashboards
+	}
+	return nil
+}
+
+// The tabs of a dashboard.
+type ListTabsResponse struct {
+	DashboardTabs        []*Resource `protobuf:"bytes,1,rep,name=dashboard_tabs,json=dashboardTabs,proto3" json:"dashboard_tabs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListTabsResponse) Reset()         { *m = ListTabsResponse{} }
+func (m *ListTabsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTabsResponse) ProtoMessage()    {}
+func (*ListTabsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *ListTabsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTabsResponse.Unmarshal(m, b)
+}
+func (m *ListTabsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTabsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTabsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTabsResponse.Merge(m, src)
+}
+func (m *ListTabsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTabsResponse.Size(m)
+}
+func (m *ListTabsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTabsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTabsResponse proto.InternalMessageInfo
+
+func (m *ListTabsResponse) GetDashboardTabs() []*Resource {
+	if m != nil {
+		return m.DashboardTabs
+	}
+	return nil
+}
+
+// The summary of each tab of a dashboard.
+type ListTabSummariesResponse struct {
+	TabSummaries         []*summary.DashboardTabSummary `protobuf:"bytes,1,rep,name=tab_summaries,json=tabSummaries,proto3" json:"tab_summaries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ListTabSummariesResponse) Reset()         { *m = ListTabSummariesResponse{} }
+func (m *ListTabSummariesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTabSummariesResponse) ProtoMessage()    {}
+func (*ListTabSummariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *ListTabSummariesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTabSummariesResponse.Unmarshal(m, b)
+}
+func (m *ListTabSummariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTabSummariesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTabSummariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTabSummariesResponse.Merge(m, src)
+}
+func (m *ListTabSummariesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTabSummariesResponse.Size(m)
+}
+func (m *ListTabSummariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTabSummariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTabSummariesResponse proto.InternalMessageInfo
+
+func (m *ListTabSummariesResponse) GetTabSummaries() []*summary.DashboardTabSummary {
+	if m != nil {
+		return m.TabSummaries
+	}
+	return nil
+}
+
+// The rows of the grid backing a dashboard tab.
+type ListRowsResponse struct {
+	Rows                 []*state.Row `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListRowsResponse) Reset()         { *m = ListRowsResponse{} }
+func (m *ListRowsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRowsResponse) ProtoMessage()    {}
+func (*ListRowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *ListRowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRowsResponse.Unmarshal(m, b)
+}
+func (m *ListRowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRowsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRowsResponse.Merge(m, src)
+}
+func (m *ListRowsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRowsResponse.Size(m)
+}
+func (m *ListRowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRowsResponse proto.InternalMessageInfo
+
+func (m *ListRowsResponse) GetRows() []*state.Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// The columns of the grid backing a dashboard tab.
+type ListColumnsResponse struct {
+	Columns              []*state.Column `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListColumnsResponse) Reset()         { *m = ListColumnsResponse{} }
+func (m *ListColumnsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListColumnsResponse) ProtoMessage()    {}
+func (*ListColumnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+func (m *ListColumnsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListColumnsResponse.Unmarshal(m, b)
+}
+func (m *ListColumnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListColumnsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListColumnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListColumnsResponse.Merge(m, src)
+}
+func (m *ListColumnsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListColumnsResponse.Size(m)
+}
+func (m *ListColumnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListColumnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListColumnsResponse proto.InternalMessageInfo
+
+func (m *ListColumnsResponse) GetColumns() []*state.Column {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Resource)(nil), "Resource")
+	proto.RegisterType((*ListDashboardGroupsResponse)(nil), "ListDashboardGroupsResponse")
+	proto.RegisterType((*ListDashboardsResponse)(nil), "ListDashboardsResponse")
+	proto.RegisterType((*ListTabsResponse)(nil), "ListTabsResponse")
+	proto.RegisterType((*ListTabSummariesResponse)(nil), "ListTabSummariesResponse")
+	proto.RegisterType((*ListRowsResponse)(nil), "ListRowsResponse")
+	proto.RegisterType((*ListColumnsResponse)(nil), "ListColumnsResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0xe9, 0xfb, 0x16, 0x67, 0x1f, 0x9d, 0x8e, 0x3a, 0x24, 0xe8, 0x65, 0xe6, 0x34, 0x41,
+	0xaa, 0x4c, 0x0f, 0x7a, 0x5e, 0xc1, 0x8b, 0xa7, 0x4c, 0xcf, 0x23, 0x59, 0x83, 0x16, 0xd7, 0xa6,
+	0x24, 0x29, 0x65, 0xff, 0xbd, 0x24, 0x69, 0x96, 0x0e, 0x76, 0x69, 0x1f, 0xbe, 0x3f, 0x3e, 0x79,
+	0x48, 0x20, 0xa1, 0x4d, 0x99, 0x35, 0x52, 0x68, 0x71, 0x33, 0x6d, 0xd8, 0xa3, 0xd2, 0x54, 0x73,
+	0xf7, 0xed, 0x55, 0x64, 0xd4, 0xb6, 0xaa, 0xa8, 0xdc, 0xf9, 0xbf, 0x73, 0xf0, 0x02, 0x4e, 0x09,
+	0x57, 0xa2, 0x95, 0x1b, 0x9e, 0xa6, 0x10, 0xd7, 0xb4, 0xe2, 0x28, 0x9a, 0x45, 0xf3, 0x84, 0xd8,
+	0xd9, 0x68, 0xdb, 0xb2, 0xfe, 0x45, 0xff, 0x9c, 0x66, 0x66, 0xbc, 0x82, 0xdb, 0x8f, 0x52, 0xe9,
+	0x9c, 0xaa, 0x1f, 0x26, 0xa8, 0x2c, 0xde, 0xa5, 0x68, 0x1b, 0x45, 0xb8, 0x6a, 0x44, 0xad, 0x78,
+	0xfa, 0x02, 0x93, 0xc2, 0x5b, 0xeb, 0x6f, 0xeb, 0xa1, 0x68, 0xf6, 0x7f, 0x7e, 0xb6, 0x48, 0x32,
+	0x7f, 0x16, 0xb9, 0x2c, 0x0e, 0xdb, 0x78, 0x09, 0xd7, 0x07, 0xd0, 0xc0, 0xbb, 0x07, 0xd8, 0x87,
+	0x8f, 0x90, 0x06, 0x26, 0xce, 0x61, 0x62, 0x20, 0x9f, 0x94, 0x85, 0xfa, 0x13, 0x5c, 0x84, 0x75,
+	0x34, 0x65, 0x47, 0x10, 0xe3, 0x7d, 0xc0, 0x34, 0xf1, 0x17, 0xa0, 0x9e, 0xb2, 0xb2, 0x77, 0x55,
+	0xf2, 0x40, 0x7b, 0x83, 0xb1, 0xa6, 0x6c, 0xad, 0xbc, 0xd1, 0xc3, 0xa6, 0x59, 0x3e, 0x40, 0xb8,
+	0xda, 0x8e, 0x9c, 0xeb, 0x01, 0x02, 0x3f, 0xb8, 0xe5, 0x88, 0xe8, 0x02, 0x0e, 0x41, 0x2c, 0x45,
+	0xe7, 0x29, 0x71, 0x46, 0x44, 0x47, 0xac, 0x82, 0x5f, 0xe1, 0xca, 0xa4, 0x97, 0x62, 0xdb, 0x56,
+	0x75, 0x28, 0xdc, 0xc1, 0x68, 0xe3, 0xa4, 0xbe, 0x33, 0xca, 0x5c, 0x84, 0x78, 0x9d, 0x9d, 0xd8,
+	0x97, 0x7d, 0xfe, 0x1b, 0x00, 0xca, 0xb3, 0x0c, 0xa3, 0x16, 0x02, 0x00, 0x00,
+}
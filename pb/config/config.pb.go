@@ -219,7 +219,7 @@ func (x AutoBugOptions_Priority) String() string {
 }
 
 func (AutoBugOptions_Priority) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{5, 0}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{6, 0}
 }
 
 // Specifies the test name, and its source
@@ -580,7 +580,21 @@ type TestGroup struct {
 	CommitOverrideStrftime string `protobuf:"bytes,55,opt,name=commit_override_strftime,json=commitOverrideStrftime,proto3" json:"commit_override_strftime,omitempty"`
 	// Specify a property that will be read into state in the user_property field.
 	// These can be substituted into LinkTemplates.
-	UserProperty         string   `protobuf:"bytes,56,opt,name=user_property,json=userProperty,proto3" json:"user_property,omitempty"`
+	UserProperty string `protobuf:"bytes,56,opt,name=user_property,json=userProperty,proto3" json:"user_property,omitempty"`
+	// Maximum number of new columns to read during each update.
+	// Defaults to 50, or num_columns_recent if larger. Groups that run
+	// frequently need a larger budget to keep days_of_results of history.
+	MaxColumnsPerUpdate int32 `protobuf:"varint,57,opt,name=max_columns_per_update,json=maxColumnsPerUpdate,proto3" json:"max_columns_per_update,omitempty"`
+	// Numeric junit properties to record as metrics on each test row.
+	// Use "*" to record every numeric property.
+	MetricProperties []string `protobuf:"bytes,58,rep,name=metric_properties,json=metricProperties,proto3" json:"metric_properties,omitempty"`
+	// If true, testcases inherit the metric_properties of their suites.
+	// Properties on the testcase take precedence.
+	SuiteMetricProperties bool `protobuf:"varint,59,opt,name=suite_metric_properties,json=suiteMetricProperties,proto3" json:"suite_metric_properties,omitempty"`
+	// Test report formats to read from build artifacts, such as "junit".
+	// Artifacts matching several formats use the first one listed.
+	// Defaults to junit.
+	ReportFormats        []string `protobuf:"bytes,60,rep,name=report_formats,json=reportFormats,proto3" json:"report_formats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1013,6 +1027,34 @@ func (m *TestGroup) GetUserProperty() string {
 	return ""
 }
 
+func (m *TestGroup) GetMaxColumnsPerUpdate() int32 {
+	if m != nil {
+		return m.MaxColumnsPerUpdate
+	}
+	return 0
+}
+
+func (m *TestGroup) GetMetricProperties() []string {
+	if m != nil {
+		return m.MetricProperties
+	}
+	return nil
+}
+
+func (m *TestGroup) GetSuiteMetricProperties() bool {
+	if m != nil {
+		return m.SuiteMetricProperties
+	}
+	return false
+}
+
+func (m *TestGroup) GetReportFormats() []string {
+	if m != nil {
+		return m.ReportFormats
+	}
+	return nil
+}
+
 // Custom column headers for defining extra column-heading rows from values in
 // the test result.
 type TestGroup_ColumnHeader struct {
@@ -1196,6 +1238,7 @@ func (m *TestGroup_KeyValue) GetValue() string {
 type TestGroup_ResultSource struct {
 	// Types that are valid to be assigned to ResultSourceConfig:
 	//	*TestGroup_ResultSource_JunitConfig
+	//	*TestGroup_ResultSource_ResultstoreConfig
 	ResultSourceConfig   isTestGroup_ResultSource_ResultSourceConfig `protobuf_oneof:"result_source_config"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
 	XXX_unrecognized     []byte                                      `json:"-"`
@@ -1235,8 +1278,14 @@ type TestGroup_ResultSource_JunitConfig struct {
 	JunitConfig *JUnitConfig `protobuf:"bytes,2,opt,name=junit_config,json=junitConfig,proto3,oneof"`
 }
 
+type TestGroup_ResultSource_ResultstoreConfig struct {
+	ResultstoreConfig *ResultStoreConfig `protobuf:"bytes,4,opt,name=resultstore_config,json=resultstoreConfig,proto3,oneof"`
+}
+
 func (*TestGroup_ResultSource_JunitConfig) isTestGroup_ResultSource_ResultSourceConfig() {}
 
+func (*TestGroup_ResultSource_ResultstoreConfig) isTestGroup_ResultSource_ResultSourceConfig() {}
+
 func (m *TestGroup_ResultSource) GetResultSourceConfig() isTestGroup_ResultSource_ResultSourceConfig {
 	if m != nil {
 		return m.ResultSourceConfig
@@ -1251,10 +1300,18 @@ func (m *TestGroup_ResultSource) GetJunitConfig() *JUnitConfig {
 	return nil
 }
 
+func (m *TestGroup_ResultSource) GetResultstoreConfig() *ResultStoreConfig {
+	if x, ok := m.GetResultSourceConfig().(*TestGroup_ResultSource_ResultstoreConfig); ok {
+		return x.ResultstoreConfig
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TestGroup_ResultSource) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TestGroup_ResultSource_JunitConfig)(nil),
+		(*TestGroup_ResultSource_ResultstoreConfig)(nil),
 	}
 }
 
@@ -1289,6 +1346,57 @@ func (m *JUnitConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JUnitConfig proto.InternalMessageInfo
 
+// Reads each ResultStore invocation matching the query into a column.
+type ResultStoreConfig struct {
+	// GCP project that owns the invocations.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// ResultStore search query selecting the invocations of this group, such as
+	// invocation_attributes.labels:"ci-foo".
+	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultStoreConfig) Reset()         { *m = ResultStoreConfig{} }
+func (m *ResultStoreConfig) String() string { return proto.CompactTextString(m) }
+func (*ResultStoreConfig) ProtoMessage()    {}
+func (*ResultStoreConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{4}
+}
+
+func (m *ResultStoreConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultStoreConfig.Unmarshal(m, b)
+}
+func (m *ResultStoreConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultStoreConfig.Marshal(b, m, deterministic)
+}
+func (m *ResultStoreConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultStoreConfig.Merge(m, src)
+}
+func (m *ResultStoreConfig) XXX_Size() int {
+	return xxx_messageInfo_ResultStoreConfig.Size(m)
+}
+func (m *ResultStoreConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultStoreConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultStoreConfig proto.InternalMessageInfo
+
+func (m *ResultStoreConfig) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ResultStoreConfig) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 // Default metadata to apply when opening bugs.
 type TestMetadataOptions struct {
 	// Apply the following metadata if this regex matches a test's name.
@@ -1310,7 +1418,7 @@ func (m *TestMetadataOptions) Reset()         { *m = TestMetadataOptions{} }
 func (m *TestMetadataOptions) String() string { return proto.CompactTextString(m) }
 func (*TestMetadataOptions) ProtoMessage()    {}
 func (*TestMetadataOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{4}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
 }
 
 func (m *TestMetadataOptions) XXX_Unmarshal(b []byte) error {
@@ -1414,7 +1522,7 @@ func (m *AutoBugOptions) Reset()         { *m = AutoBugOptions{} }
 func (m *AutoBugOptions) String() string { return proto.CompactTextString(m) }
 func (*AutoBugOptions) ProtoMessage()    {}
 func (*AutoBugOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
 }
 
 func (m *AutoBugOptions) XXX_Unmarshal(b []byte) error {
@@ -1518,7 +1626,7 @@ func (m *AutoBugOptions_DefaultTestMetadata) Reset()         { *m = AutoBugOptio
 func (m *AutoBugOptions_DefaultTestMetadata) String() string { return proto.CompactTextString(m) }
 func (*AutoBugOptions_DefaultTestMetadata) ProtoMessage()    {}
 func (*AutoBugOptions_DefaultTestMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{5, 0}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{6, 0}
 }
 
 func (m *AutoBugOptions_DefaultTestMetadata) XXX_Unmarshal(b []byte) error {
@@ -1574,7 +1682,7 @@ func (m *HotlistIdFromSource) Reset()         { *m = HotlistIdFromSource{} }
 func (m *HotlistIdFromSource) String() string { return proto.CompactTextString(m) }
 func (*HotlistIdFromSource) ProtoMessage()    {}
 func (*HotlistIdFromSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
 }
 
 func (m *HotlistIdFromSource) XXX_Unmarshal(b []byte) error {
@@ -1668,7 +1776,7 @@ func (m *Dashboard) Reset()         { *m = Dashboard{} }
 func (m *Dashboard) String() string { return proto.CompactTextString(m) }
 func (*Dashboard) ProtoMessage()    {}
 func (*Dashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{8}
 }
 
 func (m *Dashboard) XXX_Unmarshal(b []byte) error {
@@ -1755,7 +1863,7 @@ func (m *LinkTemplate) Reset()         { *m = LinkTemplate{} }
 func (m *LinkTemplate) String() string { return proto.CompactTextString(m) }
 func (*LinkTemplate) ProtoMessage()    {}
 func (*LinkTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{8}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{9}
 }
 
 func (m *LinkTemplate) XXX_Unmarshal(b []byte) error {
@@ -1812,7 +1920,7 @@ func (m *LinkOptionsTemplate) Reset()         { *m = LinkOptionsTemplate{} }
 func (m *LinkOptionsTemplate) String() string { return proto.CompactTextString(m) }
 func (*LinkOptionsTemplate) ProtoMessage()    {}
 func (*LinkOptionsTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{9}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{10}
 }
 
 func (m *LinkOptionsTemplate) XXX_Unmarshal(b []byte) error {
@@ -1925,7 +2033,7 @@ func (m *DashboardTab) Reset()         { *m = DashboardTab{} }
 func (m *DashboardTab) String() string { return proto.CompactTextString(m) }
 func (*DashboardTab) ProtoMessage()    {}
 func (*DashboardTab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{10}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{11}
 }
 
 func (m *DashboardTab) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2256,7 @@ func (m *DashboardTabAlertOptions) Reset()         { *m = DashboardTabAlertOptio
 func (m *DashboardTabAlertOptions) String() string { return proto.CompactTextString(m) }
 func (*DashboardTabAlertOptions) ProtoMessage()    {}
 func (*DashboardTabAlertOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{11}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{12}
 }
 
 func (m *DashboardTabAlertOptions) XXX_Unmarshal(b []byte) error {
@@ -2256,7 +2364,7 @@ func (m *DashboardTabFlakinessAlertOptions) Reset()         { *m = DashboardTabF
 func (m *DashboardTabFlakinessAlertOptions) String() string { return proto.CompactTextString(m) }
 func (*DashboardTabFlakinessAlertOptions) ProtoMessage()    {}
 func (*DashboardTabFlakinessAlertOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{12}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{13}
 }
 
 func (m *DashboardTabFlakinessAlertOptions) XXX_Unmarshal(b []byte) error {
@@ -2328,7 +2436,7 @@ func (m *DashboardGroup) Reset()         { *m = DashboardGroup{} }
 func (m *DashboardGroup) String() string { return proto.CompactTextString(m) }
 func (*DashboardGroup) ProtoMessage()    {}
 func (*DashboardGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{13}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{14}
 }
 
 func (m *DashboardGroup) XXX_Unmarshal(b []byte) error {
@@ -2380,7 +2488,7 @@ func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{14}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{15}
 }
 
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
@@ -2441,7 +2549,7 @@ func (m *HealthAnalysisOptions) Reset()         { *m = HealthAnalysisOptions{} }
 func (m *HealthAnalysisOptions) String() string { return proto.CompactTextString(m) }
 func (*HealthAnalysisOptions) ProtoMessage()    {}
 func (*HealthAnalysisOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{15}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{16}
 }
 
 func (m *HealthAnalysisOptions) XXX_Unmarshal(b []byte) error {
@@ -2492,7 +2600,7 @@ func (m *DefaultConfiguration) Reset()         { *m = DefaultConfiguration{} }
 func (m *DefaultConfiguration) String() string { return proto.CompactTextString(m) }
 func (*DefaultConfiguration) ProtoMessage()    {}
 func (*DefaultConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{16}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{17}
 }
 
 func (m *DefaultConfiguration) XXX_Unmarshal(b []byte) error {
@@ -2545,6 +2653,7 @@ func init() {
 	proto.RegisterType((*TestGroup_KeyValue)(nil), "TestGroup.KeyValue")
 	proto.RegisterType((*TestGroup_ResultSource)(nil), "TestGroup.ResultSource")
 	proto.RegisterType((*JUnitConfig)(nil), "JUnitConfig")
+	proto.RegisterType((*ResultStoreConfig)(nil), "ResultStoreConfig")
 	proto.RegisterType((*TestMetadataOptions)(nil), "TestMetadataOptions")
 	proto.RegisterType((*AutoBugOptions)(nil), "AutoBugOptions")
 	proto.RegisterType((*AutoBugOptions_DefaultTestMetadata)(nil), "AutoBugOptions.DefaultTestMetadata")
@@ -2564,219 +2673,227 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 3418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xdd, 0x76, 0x1b, 0x47,
-	0x72, 0x16, 0x00, 0x52, 0x02, 0x8b, 0x00, 0x39, 0x6c, 0x80, 0xe0, 0x88, 0xb4, 0x22, 0x0a, 0x5a,
-	0xad, 0xb9, 0xf6, 0x86, 0xb6, 0x28, 0x7b, 0x63, 0x65, 0xad, 0xac, 0x41, 0x12, 0x14, 0x69, 0xf1,
-	0x07, 0x3b, 0x00, 0x37, 0xc7, 0x7b, 0x33, 0x69, 0x0c, 0x1a, 0xc0, 0x2c, 0xe7, 0x07, 0x99, 0xee,
-	0xb1, 0xc8, 0x37, 0xc8, 0x3b, 0x24, 0x27, 0x57, 0x39, 0xb9, 0xf3, 0x6b, 0xe4, 0x22, 0x37, 0xb9,
-	0xca, 0xfb, 0xe4, 0x74, 0x75, 0xcf, 0x1f, 0x01, 0xc9, 0xce, 0xd9, 0x2b, 0x62, 0xea, 0xaf, 0xbb,
-	0xab, 0xab, 0xbf, 0xae, 0xaa, 0x26, 0xd4, 0x9c, 0x30, 0x18, 0xbb, 0x93, 0xfd, 0x59, 0x14, 0x8a,
-	0x70, 0xfb, 0xb3, 0xd9, 0xf0, 0x0b, 0x27, 0xe6, 0x22, 0xf4, 0x6d, 0xf6, 0x23, 0xf5, 0x62, 0x2a,
-	0xc2, 0x68, 0x8e, 0xa0, 0x64, 0xdb, 0xff, 0x56, 0x86, 0xb5, 0x01, 0xe3, 0xe2, 0x92, 0xfa, 0xec,
-	0x08, 0x8d, 0x90, 0xef, 0xa0, 0x1e, 0x50, 0x9f, 0xd9, 0xcc, 0x63, 0x3e, 0x0b, 0x04, 0x37, 0x4b,
-	0xbb, 0x95, 0xbd, 0xd5, 0x83, 0x9d, 0xfd, 0xa2, 0xdc, 0xbe, 0xfc, 0xd9, 0x55, 0x32, 0x56, 0x2d,
-	0xc8, 0x3e, 0x38, 0x79, 0x0a, 0xab, 0x68, 0x61, 0x1c, 0x46, 0x3e, 0x15, 0x66, 0x79, 0xb7, 0xb4,
-	0xb7, 0x62, 0x81, 0x24, 0x9d, 0x20, 0x65, 0xfb, 0x3f, 0x4b, 0xb0, 0x9a, 0x53, 0x27, 0x2d, 0x78,
-	0xe8, 0xd1, 0x21, 0xf3, 0xe4, 0x58, 0x52, 0x56, 0x7f, 0x91, 0xe7, 0x50, 0x17, 0x34, 0x9a, 0x30,
-	0x61, 0xab, 0x05, 0x6a, 0x53, 0x35, 0x45, 0xd4, 0xf3, 0x7d, 0x06, 0xb5, 0x61, 0xec, 0x7a, 0x23,
-	0x5b, 0x51, 0xcd, 0xca, 0x6e, 0x69, 0xaf, 0x6a, 0xad, 0x22, 0x6d, 0x80, 0x24, 0x42, 0x60, 0x49,
-	0xd0, 0x09, 0x37, 0x97, 0x50, 0x1d, 0x7f, 0xa3, 0x6d, 0xc6, 0x85, 0x3d, 0x8b, 0xc2, 0x19, 0x8b,
-	0xc4, 0x9d, 0xb9, 0xac, 0x6d, 0x33, 0x2e, 0x7a, 0x9a, 0xd6, 0x7e, 0x07, 0xb5, 0xcb, 0x50, 0xb8,
-	0x63, 0xd7, 0xa1, 0xc2, 0x0d, 0x03, 0x62, 0xc2, 0x23, 0x1e, 0xfb, 0x3e, 0x8d, 0xee, 0xf4, 0x4c,
-	0x93, 0x4f, 0x39, 0x0b, 0x27, 0x0c, 0x04, 0xbb, 0x15, 0xb6, 0xe7, 0x06, 0x37, 0x7a, 0xa6, 0xab,
-	0x9a, 0x76, 0xee, 0x06, 0x37, 0xed, 0x7f, 0x7f, 0x0a, 0x2b, 0xd2, 0x87, 0x6f, 0xa3, 0x30, 0x9e,
-	0xc9, 0x39, 0x49, 0x8f, 0x68, 0x3b, 0xf8, 0x9b, 0x3c, 0x01, 0x98, 0x38, 0xdc, 0x9e, 0x45, 0x6c,
-	0xec, 0xde, 0x6a, 0x13, 0x2b, 0x13, 0x87, 0xf7, 0x90, 0x40, 0x7e, 0x0d, 0xeb, 0x23, 0x7a, 0xc7,
-	0xed, 0x70, 0x6c, 0x47, 0x8c, 0xc7, 0x9e, 0xe0, 0xb8, 0xd8, 0x65, 0xab, 0x2e, 0xc9, 0x57, 0x63,
-	0x4b, 0x11, 0xc9, 0x0b, 0x58, 0x73, 0x27, 0x41, 0x18, 0x31, 0x7b, 0xc6, 0x82, 0x91, 0x1b, 0x4c,
-	0x70, 0xe1, 0x55, 0xab, 0xae, 0xa8, 0x3d, 0x45, 0x94, 0x53, 0xd6, 0x62, 0xd2, 0x57, 0x02, 0x1d,
-	0x50, 0xb5, 0x56, 0x15, 0xed, 0x50, 0x92, 0xc8, 0x77, 0xb0, 0x21, 0xfd, 0xc1, 0x6d, 0xdc, 0xcf,
-	0x59, 0xe8, 0xb9, 0xce, 0x9d, 0xf9, 0x70, 0xb7, 0xb4, 0xb7, 0x76, 0xd0, 0xdc, 0x4f, 0xd7, 0x82,
-	0xbf, 0xb8, 0xdc, 0x50, 0x6b, 0x5d, 0x24, 0x3f, 0x7b, 0x28, 0x4c, 0xbe, 0x81, 0xd6, 0x84, 0x8a,
-	0x29, 0x8b, 0xec, 0xbc, 0xb7, 0x5d, 0xc6, 0xcd, 0x47, 0x72, 0xb8, 0xc3, 0xb2, 0x59, 0xb2, 0x9a,
-	0x4a, 0x62, 0x90, 0x79, 0xde, 0x65, 0x9c, 0x1c, 0xc0, 0xa6, 0x9e, 0x1e, 0x6a, 0xf2, 0x78, 0xc8,
-	0x45, 0x24, 0x17, 0x53, 0xdd, 0xad, 0xec, 0xad, 0x58, 0x0d, 0xc5, 0x94, 0x4a, 0xfd, 0x84, 0x45,
-	0xbe, 0x85, 0xba, 0x13, 0x7a, 0xb1, 0x1f, 0xd8, 0x53, 0x46, 0x47, 0x2c, 0x32, 0x57, 0x30, 0x76,
-	0xb7, 0x72, 0x73, 0x3d, 0x42, 0xfe, 0x29, 0xb2, 0xad, 0x9a, 0x93, 0xfb, 0x22, 0xa7, 0xb0, 0x31,
-	0xa6, 0x9e, 0x37, 0xa4, 0xce, 0x8d, 0x3d, 0x91, 0xc2, 0x72, 0x34, 0xc0, 0xd5, 0xee, 0xe4, 0x2c,
-	0x9c, 0x68, 0x99, 0xb7, 0x5a, 0xc4, 0x32, 0xc6, 0xf7, 0x28, 0xe4, 0x0d, 0x3c, 0xa6, 0x1e, 0x8b,
-	0x84, 0xcd, 0x05, 0xf5, 0x58, 0xb2, 0x5b, 0xf6, 0x34, 0x8c, 0x23, 0x6e, 0xae, 0xca, 0x3d, 0xc3,
-	0x85, 0xb7, 0x50, 0xa8, 0x2f, 0x65, 0xf4, 0xde, 0x9d, 0x4a, 0x09, 0xf2, 0x35, 0x6c, 0x06, 0xb1,
-	0x6f, 0x8f, 0xa9, 0xeb, 0xc5, 0x11, 0xe3, 0xb6, 0x08, 0x6d, 0x94, 0x34, 0x6b, 0xa9, 0x2a, 0x09,
-	0x62, 0xff, 0x44, 0xf3, 0x07, 0x61, 0x47, 0x72, 0x65, 0x48, 0x0f, 0xe3, 0x89, 0xed, 0x84, 0xfe,
-	0x2c, 0x0c, 0x58, 0x20, 0xcc, 0x3a, 0x46, 0x47, 0x6d, 0x18, 0x4f, 0x8e, 0x12, 0x1a, 0xd9, 0x03,
-	0xc3, 0x09, 0x47, 0xcc, 0xe6, 0x8c, 0x46, 0xce, 0xd4, 0x9e, 0x51, 0x31, 0x35, 0xd7, 0x30, 0xd2,
-	0xd6, 0x24, 0xbd, 0x8f, 0xe4, 0x1e, 0x15, 0x53, 0xf2, 0x5b, 0x90, 0x83, 0xd8, 0xca, 0x45, 0xdc,
-	0x8e, 0x98, 0x23, 0x6d, 0xae, 0xa3, 0x4d, 0x23, 0x88, 0x7d, 0xe5, 0x49, 0x6e, 0x21, 0x9d, 0x7c,
-	0x06, 0x1b, 0x31, 0xd7, 0x7b, 0xe5, 0x33, 0x41, 0x47, 0x54, 0x50, 0xd3, 0xc0, 0x90, 0x5a, 0x8f,
-	0x39, 0xee, 0xd3, 0x85, 0x26, 0x93, 0xd7, 0xb0, 0xa5, 0xdc, 0xe3, 0x53, 0xd7, 0xc3, 0xd5, 0x8d,
-	0x46, 0x11, 0xe3, 0x9c, 0x71, 0x73, 0x43, 0x4e, 0x45, 0x45, 0x05, 0x8a, 0x5c, 0x50, 0xd7, 0x1b,
-	0x84, 0x9d, 0x84, 0x4f, 0xbe, 0x04, 0x92, 0x53, 0xe5, 0xf1, 0xf0, 0x2f, 0xcc, 0x11, 0x26, 0x49,
-	0xb5, 0x8c, 0x54, 0xab, 0xaf, 0x78, 0xe4, 0x0f, 0xb0, 0x9d, 0xd3, 0xd0, 0x3e, 0xb5, 0x7d, 0xc6,
-	0x39, 0x9d, 0x30, 0xb3, 0x91, 0x6a, 0x6e, 0xa5, 0x9a, 0xda, 0xaf, 0x17, 0x4a, 0x84, 0xbc, 0x82,
-	0x66, 0xce, 0xc0, 0x88, 0x49, 0x1f, 0xc7, 0x91, 0x67, 0x36, 0x53, 0xd5, 0x8d, 0x54, 0xf5, 0x58,
-	0x72, 0xaf, 0x23, 0x8f, 0x9c, 0xc3, 0x33, 0xdf, 0x0d, 0x6c, 0xe6, 0xd1, 0x19, 0x67, 0x23, 0xdb,
-	0x77, 0x83, 0x58, 0x30, 0x6e, 0x0f, 0x99, 0x78, 0xcf, 0x58, 0x80, 0xa6, 0xb8, 0xb9, 0x99, 0x6e,
-	0xe7, 0x13, 0xdf, 0x0d, 0xba, 0x4a, 0xf6, 0x42, 0x89, 0x1e, 0x2a, 0x49, 0x69, 0x94, 0x93, 0x1f,
-	0x60, 0x4f, 0x3a, 0x57, 0xa1, 0x60, 0x1c, 0x21, 0x18, 0xd9, 0x12, 0xca, 0x19, 0xb7, 0x29, 0x57,
-	0xc1, 0x61, 0xcf, 0x68, 0x44, 0x7d, 0x6e, 0xb6, 0xd2, 0x73, 0xf5, 0x3c, 0xe6, 0x1a, 0xac, 0xb5,
-	0xca, 0x9f, 0x50, 0xa3, 0xc3, 0x31, 0x5c, 0x7a, 0x28, 0x4e, 0xf6, 0xa1, 0xc1, 0x02, 0x3a, 0xf4,
-	0x98, 0x3d, 0xf6, 0xe8, 0xcd, 0x9d, 0x8c, 0x58, 0x11, 0x73, 0x73, 0x0b, 0x77, 0x6e, 0x43, 0xb1,
-	0x4e, 0x24, 0xa7, 0x8f, 0x0c, 0x79, 0x2c, 0xe5, 0x54, 0x6e, 0xe2, 0x21, 0x8b, 0x02, 0x26, 0xd7,
-	0xe4, 0x78, 0xae, 0x0c, 0x0c, 0x13, 0x35, 0x1a, 0x31, 0x67, 0xef, 0x52, 0xde, 0x11, 0xb2, 0xe4,
-	0x85, 0xe0, 0x72, 0x9b, 0xdd, 0x0a, 0x16, 0x05, 0xd4, 0x33, 0x1f, 0xa3, 0x24, 0xb8, 0xbc, 0xab,
-	0x29, 0xe4, 0x35, 0x18, 0x18, 0x38, 0x08, 0x33, 0x1a, 0xeb, 0xb7, 0x77, 0x4b, 0x7b, 0xab, 0x07,
-	0xeb, 0xf7, 0xae, 0x1d, 0x6b, 0x4d, 0x14, 0xaf, 0xab, 0x57, 0x50, 0x0f, 0x72, 0x10, 0xcd, 0xcd,
-	0x1d, 0x3c, 0xf2, 0xf5, 0xfd, 0x3c, 0x70, 0x5b, 0x45, 0x19, 0xf2, 0x06, 0xd6, 0x34, 0x4e, 0xf0,
-	0x30, 0x12, 0xf6, 0xf0, 0xce, 0xfc, 0x04, 0x8f, 0xf9, 0x3c, 0x50, 0xf4, 0xc3, 0x48, 0x1c, 0xde,
-	0x25, 0x40, 0xa1, 0xbe, 0x48, 0x17, 0x8c, 0x59, 0xe4, 0x4a, 0xdc, 0xcf, 0x70, 0xe2, 0x09, 0x1a,
-	0xd8, 0xce, 0x19, 0xe8, 0x29, 0x91, 0x14, 0x26, 0xd6, 0x67, 0x45, 0x42, 0xce, 0xf5, 0xc9, 0xa9,
-	0x99, 0x86, 0x23, 0x6e, 0xfe, 0x4d, 0xde, 0xf5, 0xfa, 0xdc, 0x48, 0x06, 0x39, 0xd6, 0x5e, 0xa2,
-	0x41, 0x10, 0x0a, 0xbd, 0xda, 0xa7, 0xb8, 0xda, 0xc7, 0xf7, 0xc0, 0xb8, 0x93, 0x4a, 0x28, 0x44,
-	0xce, 0xbe, 0x39, 0xf9, 0x06, 0x1e, 0xfb, 0xf4, 0xb6, 0x30, 0xa4, 0x3d, 0xd3, 0xf8, 0x6c, 0xee,
-	0xe2, 0xe9, 0xde, 0xf4, 0xe9, 0x6d, 0x6e, 0xe0, 0x9e, 0xc2, 0x66, 0xd2, 0x81, 0x27, 0x4e, 0xe8,
-	0xfb, 0xae, 0xb0, 0xc3, 0x1f, 0x59, 0x14, 0xb9, 0x23, 0x66, 0xe3, 0x45, 0x2d, 0x41, 0x44, 0x6e,
-	0xa4, 0xf9, 0x0c, 0x71, 0x64, 0x5b, 0x09, 0x5d, 0x69, 0x99, 0x73, 0x29, 0xd2, 0x53, 0x12, 0xe4,
-	0x14, 0x36, 0x0b, 0x08, 0x61, 0x87, 0x33, 0xb5, 0x8e, 0x36, 0xae, 0x43, 0x5d, 0x2a, 0x09, 0x4e,
-	0x5c, 0x29, 0x9e, 0xd5, 0x10, 0xf3, 0x44, 0x89, 0x63, 0x68, 0x49, 0xd0, 0x49, 0x3a, 0xfe, 0x73,
-	0x85, 0x63, 0x92, 0x3e, 0xa0, 0x93, 0x64, 0xcc, 0xd7, 0x60, 0xd0, 0x58, 0x84, 0xb6, 0x3c, 0xb7,
-	0xc9, 0x70, 0xbf, 0xd2, 0xc1, 0xd5, 0x89, 0x45, 0x78, 0x18, 0x4f, 0x92, 0x91, 0xd6, 0x68, 0xe1,
-	0x9b, 0xbc, 0x82, 0x56, 0xea, 0xab, 0x28, 0x0e, 0x84, 0xeb, 0x33, 0x0d, 0xe2, 0x2f, 0xd0, 0x51,
-	0x0d, 0xed, 0x28, 0x4b, 0xf1, 0x14, 0x7a, 0x7f, 0x0b, 0x3b, 0x12, 0x37, 0x67, 0x54, 0x02, 0x96,
-	0x44, 0xb7, 0x91, 0xcb, 0x71, 0x97, 0x15, 0x86, 0xff, 0x1a, 0x35, 0xb7, 0x82, 0xd8, 0xef, 0xa1,
-	0xc4, 0x20, 0x3c, 0x56, 0x7c, 0x05, 0xe2, 0x9f, 0x03, 0x91, 0x09, 0x84, 0x9c, 0x2d, 0xb7, 0x87,
-	0x3a, 0xc0, 0xcc, 0x4f, 0x15, 0x90, 0x4a, 0xce, 0x61, 0x3c, 0xe1, 0x87, 0x2a, 0x88, 0xc8, 0x19,
-	0x34, 0x59, 0xf0, 0xa3, 0x1b, 0x85, 0x81, 0xcc, 0xa3, 0x6c, 0x37, 0xe0, 0x82, 0x06, 0x0e, 0x33,
-	0xf7, 0x30, 0x18, 0x5b, 0xb9, 0xa8, 0xe8, 0x66, 0x62, 0x56, 0x23, 0xa7, 0x73, 0xa6, 0x55, 0xc8,
-	0x19, 0xb4, 0x72, 0x21, 0x91, 0xbf, 0xa8, 0x7f, 0x83, 0x5b, 0xd3, 0xc8, 0x19, 0x7b, 0xc7, 0xee,
-	0x10, 0x4a, 0xac, 0xa6, 0x48, 0xa3, 0x24, 0x77, 0x73, 0x3f, 0x85, 0x55, 0x7d, 0xe7, 0xcb, 0x45,
-	0x98, 0x9f, 0xa9, 0xe3, 0xae, 0x48, 0x72, 0xf6, 0xf2, 0xae, 0xe0, 0x53, 0x79, 0xf0, 0x30, 0x5f,
-	0xf2, 0x99, 0x88, 0x5c, 0xc7, 0xfc, 0x1c, 0x37, 0x6f, 0x1d, 0x19, 0x03, 0x76, 0x2b, 0xcd, 0x46,
-	0xae, 0x43, 0x2e, 0xe0, 0xf9, 0xfd, 0xa0, 0x5b, 0x00, 0x83, 0xe6, 0x6f, 0x51, 0x7b, 0xb7, 0x18,
-	0x7a, 0xf3, 0xe0, 0x27, 0xa3, 0xbf, 0xe0, 0xde, 0xc2, 0xc9, 0xfb, 0x5b, 0x9c, 0xe9, 0x66, 0xe6,
-	0xe5, 0xfc, 0xe9, 0xfb, 0x1a, 0xb6, 0xf2, 0x0e, 0xf2, 0xa9, 0x70, 0xa6, 0x76, 0xc4, 0x26, 0xec,
-	0xd6, 0xdc, 0xc7, 0xc1, 0x73, 0xce, 0xb8, 0x90, 0x4c, 0x4b, 0xf2, 0xc8, 0x4b, 0x85, 0x97, 0xe3,
-	0xd8, 0xf3, 0x12, 0x55, 0x89, 0x72, 0xdc, 0xfc, 0x02, 0x07, 0x23, 0x31, 0x67, 0x27, 0xb1, 0xe7,
-	0x29, 0x3d, 0x89, 0x6b, 0x9c, 0x74, 0xe1, 0x89, 0x4e, 0xd7, 0x55, 0xe2, 0x90, 0x65, 0xed, 0x76,
-	0x14, 0x7b, 0x8c, 0x9b, 0x5f, 0xca, 0x0c, 0x08, 0x21, 0x7e, 0x5b, 0x09, 0xaa, 0xec, 0xa1, 0x9b,
-	0x88, 0x59, 0x52, 0x8a, 0xfc, 0x11, 0x5e, 0xcc, 0xa5, 0x33, 0x0b, 0x7d, 0xf7, 0x12, 0xa7, 0xdf,
-	0xbe, 0x9f, 0xc5, 0x2c, 0xf0, 0xde, 0xb7, 0x50, 0xd7, 0x53, 0xe2, 0x61, 0x1c, 0x39, 0xcc, 0x3c,
-	0xc0, 0x73, 0x94, 0x87, 0x4d, 0x35, 0x95, 0x3e, 0xb2, 0xad, 0x5a, 0x94, 0xfb, 0x22, 0x47, 0xf0,
-	0xf8, 0x7e, 0x19, 0x82, 0x0b, 0xb2, 0x39, 0x13, 0xe6, 0x2b, 0xb4, 0x54, 0xdd, 0x97, 0x73, 0xef,
-	0x33, 0x61, 0xb5, 0x94, 0x68, 0x61, 0x4d, 0x7d, 0x26, 0xe4, 0x36, 0x44, 0x8c, 0x8e, 0xf0, 0x9e,
-	0x62, 0xf6, 0x38, 0x0a, 0x7d, 0x9b, 0x8b, 0x30, 0x92, 0x77, 0xf9, 0x57, 0xe8, 0xd1, 0xa6, 0x64,
-	0xcb, 0xcb, 0x8a, 0x9d, 0x44, 0xa1, 0xdf, 0x57, 0x3c, 0x99, 0xcc, 0xe8, 0x6c, 0x32, 0xf4, 0x46,
-	0x69, 0xfa, 0xfc, 0x35, 0x6a, 0x18, 0x8a, 0x73, 0xe5, 0x8d, 0x92, 0x0c, 0x5a, 0x5e, 0x58, 0x4a,
-	0x9a, 0xdf, 0xb8, 0x33, 0xf3, 0x77, 0xfa, 0xc2, 0x42, 0x52, 0xff, 0xc6, 0x9d, 0x91, 0x6f, 0xc0,
-	0xbc, 0x1f, 0x95, 0x5c, 0x44, 0x63, 0x09, 0x02, 0xe6, 0xdf, 0xa1, 0x3b, 0x5b, 0xc5, 0x50, 0xec,
-	0x6b, 0xae, 0x4c, 0xd2, 0x62, 0xce, 0xa2, 0xac, 0xee, 0xf8, 0x46, 0xd5, 0x1d, 0x92, 0x98, 0xd4,
-	0x1d, 0xdb, 0xff, 0x0c, 0xb5, 0x7c, 0x9e, 0x4a, 0x9a, 0xb0, 0x8c, 0x48, 0xab, 0xab, 0x05, 0xf5,
-	0x41, 0xb6, 0xa1, 0x9a, 0x5a, 0x51, 0xc5, 0x42, 0xfa, 0x4d, 0xbe, 0x80, 0xc6, 0xa2, 0xad, 0xae,
-	0xa0, 0x18, 0x71, 0xe6, 0xb6, 0x76, 0x9b, 0xab, 0x42, 0x30, 0xbb, 0x29, 0x64, 0x35, 0x92, 0x9d,
-	0x52, 0x3d, 0xf2, 0x4a, 0x7a, 0x3c, 0xc9, 0x0b, 0xa8, 0x27, 0xa3, 0x61, 0x44, 0xab, 0x29, 0x9c,
-	0x3e, 0xb0, 0x6a, 0x09, 0x59, 0x46, 0xf3, 0xe1, 0x0e, 0x3c, 0x2e, 0x9c, 0x75, 0xcc, 0xa9, 0x74,
-	0xf8, 0x6c, 0x1f, 0x40, 0x35, 0xc1, 0x12, 0x62, 0x40, 0xe5, 0x86, 0x25, 0x75, 0x95, 0xfc, 0x29,
-	0x57, 0xad, 0x66, 0xad, 0x16, 0xa7, 0x3e, 0xb6, 0x19, 0xd4, 0xf2, 0x31, 0x46, 0x5e, 0x42, 0xed,
-	0x2f, 0x71, 0xe0, 0x16, 0x6a, 0xc4, 0xd5, 0x83, 0xda, 0xfe, 0xf7, 0xd7, 0x81, 0xab, 0x6b, 0xc4,
-	0xd3, 0x07, 0xd6, 0x2a, 0xca, 0xa8, 0xcf, 0xc3, 0x16, 0x34, 0x0b, 0x61, 0xac, 0x55, 0xbf, 0x5f,
-	0xaa, 0x96, 0x8c, 0xf2, 0xf7, 0x4b, 0xd5, 0x8a, 0xb1, 0xd4, 0xf6, 0x55, 0xb1, 0x86, 0xb5, 0x0c,
-	0xd9, 0x86, 0xd6, 0xa0, 0xdb, 0x1f, 0xf4, 0xed, 0xcb, 0xce, 0x45, 0xd7, 0xbe, 0xbe, 0xec, 0xf7,
-	0xba, 0x47, 0x67, 0x27, 0x67, 0xdd, 0x63, 0xe3, 0x01, 0xd9, 0x84, 0x8d, 0x1c, 0xef, 0xec, 0xed,
-	0xe5, 0x95, 0xd5, 0x35, 0x4a, 0xa4, 0x05, 0x24, 0x47, 0xb6, 0xba, 0xbd, 0xf3, 0xce, 0x51, 0xd7,
-	0x28, 0xdf, 0x13, 0xef, 0xf4, 0x7a, 0xdd, 0xcb, 0x63, 0xa3, 0xd2, 0xfe, 0x9f, 0x12, 0x18, 0xf7,
-	0x0b, 0x0b, 0x39, 0xec, 0x49, 0xe7, 0xfc, 0xfc, 0xb0, 0x73, 0xf4, 0xce, 0x7e, 0x6b, 0x5d, 0x5d,
-	0xf7, 0xce, 0x2e, 0xdf, 0xda, 0x97, 0x57, 0x97, 0x5d, 0xe3, 0xc1, 0x62, 0xde, 0x71, 0x67, 0x20,
-	0xc7, 0xfe, 0x04, 0xcc, 0x79, 0xde, 0x79, 0xe7, 0xb0, 0x7b, 0xde, 0x37, 0xca, 0xc4, 0x84, 0xe6,
-	0x3c, 0xf7, 0xec, 0xd8, 0xa8, 0x90, 0x5d, 0xf8, 0x64, 0x9e, 0x73, 0x74, 0x75, 0x71, 0x71, 0x36,
-	0xb0, 0x2f, 0xaf, 0x2f, 0x8c, 0x25, 0xf2, 0x1b, 0x78, 0xb1, 0x48, 0xe2, 0xf2, 0xe4, 0xec, 0xed,
-	0xb5, 0xd5, 0x19, 0x9c, 0x5d, 0x5d, 0xda, 0x7f, 0xea, 0x9c, 0x5f, 0x77, 0x8d, 0xe5, 0xf6, 0x77,
-	0x49, 0x0c, 0xeb, 0xa4, 0xa9, 0x09, 0xc6, 0xd1, 0xd5, 0xf9, 0xf5, 0xc5, 0xa5, 0xdd, 0xbf, 0xb2,
-	0x06, 0x6a, 0xaa, 0xb8, 0x8c, 0x3c, 0x35, 0x37, 0x58, 0xa9, 0x7d, 0x01, 0xeb, 0xf7, 0x72, 0x28,
-	0xf2, 0x18, 0x36, 0x7b, 0xd6, 0xd9, 0x45, 0xc7, 0xfa, 0x61, 0xce, 0x21, 0x4f, 0x61, 0x67, 0x8e,
-	0x55, 0x30, 0xf7, 0x14, 0x56, 0x73, 0xb7, 0x20, 0xa9, 0xc2, 0x52, 0xcf, 0xba, 0x92, 0x3b, 0xf8,
-	0x10, 0xca, 0x7f, 0xec, 0x18, 0xa5, 0x76, 0x1d, 0x56, 0x73, 0x41, 0xd3, 0xfe, 0xa9, 0x04, 0x8d,
-	0x05, 0xe9, 0x88, 0x2c, 0xc3, 0xb3, 0x64, 0x55, 0x5d, 0x00, 0x2a, 0x68, 0xeb, 0x49, 0x6a, 0xaa,
-	0x90, 0x7f, 0xae, 0x1c, 0x2b, 0x2f, 0x28, 0xc7, 0x9a, 0xb0, 0x1c, 0xbe, 0x0f, 0x58, 0xa4, 0x4f,
-	0xa6, 0xfa, 0x20, 0x6b, 0x50, 0x76, 0x1c, 0x73, 0x09, 0x0b, 0xdd, 0xb2, 0xe3, 0x48, 0x53, 0xc9,
-	0xc9, 0x51, 0x03, 0xea, 0x66, 0x85, 0x26, 0xe2, 0x78, 0xed, 0xff, 0x5e, 0x86, 0xb5, 0x62, 0x3e,
-	0x43, 0xbe, 0x82, 0xd6, 0x90, 0x09, 0x6a, 0xcb, 0xb4, 0xa6, 0x38, 0x17, 0xc0, 0xb9, 0x34, 0x25,
-	0xb7, 0xa3, 0x98, 0xd9, 0x9c, 0x9e, 0x00, 0x60, 0xc2, 0xe4, 0x78, 0x21, 0x57, 0x0d, 0x8a, 0xaa,
-	0xb5, 0x22, 0x29, 0x47, 0x92, 0x20, 0xc1, 0x71, 0x1a, 0x0a, 0xcf, 0xe5, 0xc2, 0x76, 0x47, 0xdc,
-	0x2c, 0xef, 0x56, 0xf6, 0x2a, 0x16, 0x68, 0xd2, 0xd9, 0x48, 0x8e, 0x5a, 0x9d, 0x45, 0x6e, 0x18,
-	0xb9, 0xe2, 0x0e, 0x97, 0xb5, 0x76, 0x60, 0xde, 0x4b, 0xb4, 0x64, 0x6e, 0x8c, 0x7c, 0x2b, 0x95,
-	0x24, 0xef, 0x60, 0x2b, 0x67, 0x56, 0x23, 0xbb, 0xba, 0x65, 0x96, 0x74, 0x72, 0x78, 0x9a, 0x8c,
-	0x81, 0xc8, 0xae, 0xae, 0x98, 0x66, 0x36, 0x70, 0x46, 0x25, 0x9f, 0xc2, 0xfa, 0xd8, 0xf5, 0x98,
-	0xed, 0x06, 0x23, 0xf7, 0x47, 0x77, 0x14, 0x53, 0x4f, 0xb7, 0x37, 0xd6, 0x24, 0xf9, 0x2c, 0xa5,
-	0x92, 0xcf, 0x61, 0x83, 0xbb, 0xc1, 0xc4, 0x63, 0x22, 0x0c, 0x12, 0x37, 0x61, 0x87, 0xa3, 0x6a,
-	0x19, 0x29, 0x43, 0x7b, 0x88, 0xbc, 0x81, 0x1d, 0x99, 0x0e, 0x52, 0xcf, 0x0b, 0xdf, 0xb3, 0x51,
-	0xce, 0xb8, 0x4a, 0x74, 0x1e, 0xa1, 0x4f, 0x4d, 0x9f, 0xde, 0x76, 0x94, 0x44, 0x36, 0x0e, 0xa6,
-	0x3d, 0xcf, 0xa0, 0x86, 0x93, 0x92, 0x57, 0x06, 0xf5, 0x3c, 0xb3, 0xaa, 0x1a, 0x2e, 0x92, 0x76,
-	0xa5, 0x48, 0xe4, 0x1f, 0x61, 0x73, 0xc4, 0xc6, 0x54, 0x42, 0x53, 0xb1, 0x92, 0x5e, 0x41, 0x54,
-	0x7b, 0x7e, 0xdf, 0x8f, 0xc7, 0x4a, 0x38, 0x1f, 0xa6, 0x56, 0x63, 0x34, 0x4f, 0xdc, 0xfe, 0x27,
-	0x68, 0x2c, 0x90, 0x9d, 0x8f, 0xd1, 0xd2, 0xc7, 0x62, 0xb4, 0x3c, 0x1f, 0xa3, 0x2a, 0x6c, 0xcb,
-	0x8e, 0xd3, 0x3e, 0x87, 0x6a, 0xb2, 0xab, 0x12, 0x62, 0x7a, 0xd6, 0xd9, 0x95, 0x75, 0x36, 0xf8,
-	0xe1, 0x1e, 0x5a, 0x3e, 0x84, 0x72, 0xef, 0x4b, 0xa3, 0x84, 0x7f, 0x5f, 0x1a, 0x65, 0xfc, 0x7b,
-	0x60, 0x54, 0xf0, 0xef, 0x2b, 0x63, 0x09, 0xff, 0x7e, 0x65, 0x2c, 0xb7, 0xff, 0x0c, 0x8d, 0x05,
-	0xbb, 0x4d, 0x5a, 0xc9, 0x95, 0x20, 0xe7, 0x59, 0x39, 0x7d, 0xa0, 0x2f, 0x05, 0x49, 0x57, 0x17,
-	0x64, 0x72, 0x09, 0xa9, 0xcf, 0xc3, 0x06, 0x6c, 0x64, 0x41, 0xa5, 0xc3, 0xa9, 0xfd, 0x5f, 0x65,
-	0x58, 0x39, 0xa6, 0x7c, 0x3a, 0x0c, 0x69, 0x34, 0x22, 0x07, 0x50, 0x1f, 0x25, 0x1f, 0xb6, 0xa0,
-	0x43, 0xdd, 0xef, 0xac, 0xef, 0xa7, 0x22, 0x03, 0x3a, 0xb4, 0x6a, 0xa3, 0xdc, 0x57, 0xda, 0xbc,
-	0x2b, 0xe7, 0x9a, 0x77, 0x73, 0x85, 0x68, 0xe5, 0x17, 0x14, 0xa2, 0x4f, 0x61, 0x35, 0xdd, 0x6f,
-	0x3a, 0xd4, 0xc7, 0x1a, 0x92, 0x0d, 0xa4, 0x43, 0x59, 0x6e, 0x8f, 0xc2, 0xf7, 0xc1, 0xcc, 0xa3,
-	0x77, 0xd8, 0xbb, 0x90, 0x39, 0x9c, 0xa0, 0x43, 0xae, 0x83, 0xa7, 0x91, 0x30, 0x4f, 0x14, 0x6f,
-	0x40, 0x87, 0xb2, 0xc2, 0x6b, 0x4d, 0xdd, 0xc9, 0xd4, 0x73, 0x27, 0x53, 0x51, 0x54, 0x7a, 0x98,
-	0xf5, 0xdc, 0x52, 0x89, 0xbc, 0xe6, 0xa7, 0xb0, 0x9e, 0x69, 0x8a, 0x70, 0x44, 0xef, 0x54, 0x9b,
-	0xce, 0x5a, 0x4b, 0xc9, 0x03, 0x49, 0xfd, 0x7e, 0xa9, 0xba, 0x64, 0x2c, 0xb7, 0x47, 0x50, 0x3b,
-	0x77, 0x83, 0x9b, 0x01, 0xf3, 0x67, 0x1e, 0x15, 0x78, 0x85, 0xc7, 0x51, 0x92, 0xa4, 0xc8, 0x9f,
-	0x64, 0x1f, 0x1e, 0x25, 0x25, 0x57, 0x59, 0x1f, 0x62, 0xa9, 0xa1, 0xc3, 0x37, 0x51, 0xb4, 0x12,
-	0xa1, 0xd4, 0xb1, 0x95, 0xcc, 0xb1, 0xed, 0x37, 0xd0, 0x58, 0xa0, 0xf3, 0x4b, 0xf3, 0x85, 0xf6,
-	0xbf, 0x00, 0xd4, 0x8e, 0x17, 0x6d, 0x5e, 0xbe, 0xf3, 0x9a, 0x60, 0x3a, 0xe6, 0xc9, 0xb9, 0x74,
-	0x46, 0x61, 0x3a, 0x5e, 0x3f, 0x98, 0x08, 0xcc, 0x9d, 0x97, 0xca, 0x2f, 0x6c, 0xb1, 0x2d, 0xfd,
-	0x3f, 0x5a, 0x6c, 0xcb, 0x1f, 0x68, 0xb1, 0x3d, 0x83, 0xda, 0x90, 0x72, 0x96, 0x16, 0xb1, 0x0f,
-	0x55, 0x8f, 0x59, 0xd2, 0x12, 0xc0, 0xff, 0x3d, 0x90, 0x70, 0xc6, 0x02, 0x05, 0x1e, 0x42, 0xbb,
-	0x0a, 0xf7, 0x50, 0x46, 0x62, 0x7e, 0xb3, 0x2c, 0x43, 0x0a, 0x4a, 0x30, 0x48, 0x3d, 0xfa, 0x1a,
-	0x36, 0x10, 0x9f, 0xe4, 0x0a, 0x53, 0xdd, 0xea, 0x22, 0x5d, 0x04, 0xd7, 0xc3, 0x78, 0x92, 0xaa,
-	0xbe, 0x81, 0x06, 0x15, 0x82, 0x3a, 0xd3, 0xa2, 0xf2, 0xca, 0x22, 0xe5, 0x0d, 0x25, 0x99, 0x57,
-	0x7f, 0x06, 0xb5, 0xa4, 0x47, 0x8a, 0xc9, 0x26, 0xa8, 0x95, 0x69, 0x1a, 0xa6, 0x9b, 0x7f, 0x48,
-	0x72, 0x36, 0x6e, 0xc7, 0x91, 0x97, 0x0d, 0xb1, 0xba, 0x68, 0x08, 0xa2, 0x45, 0xaf, 0x23, 0x2f,
-	0x1d, 0xe3, 0x44, 0xa6, 0xec, 0xd9, 0xae, 0x14, 0x8c, 0xd4, 0x16, 0x19, 0xd9, 0xcc, 0x36, 0x2b,
-	0x6f, 0x67, 0x57, 0x1e, 0x59, 0xee, 0x44, 0x2e, 0xba, 0x1c, 0x7b, 0xac, 0x2b, 0x56, 0x9e, 0x44,
-	0xf6, 0xa1, 0x21, 0xe8, 0x30, 0xf6, 0x68, 0xa4, 0x4a, 0x3d, 0x7d, 0x67, 0xab, 0x2e, 0xeb, 0x86,
-	0x66, 0x61, 0xa9, 0xa7, 0x12, 0x85, 0x7f, 0x80, 0xba, 0xea, 0xe0, 0x25, 0x1b, 0xbb, 0x8e, 0xd3,
-	0x79, 0x5c, 0x40, 0x20, 0xec, 0x0e, 0x24, 0x7d, 0x8a, 0x1a, 0xcd, 0x7d, 0x91, 0x3f, 0xc3, 0xd6,
-	0xd8, 0xa3, 0x37, 0x6e, 0xc0, 0x78, 0xd2, 0x0b, 0x4c, 0x2c, 0x99, 0x68, 0xa9, 0x5d, 0xb0, 0x74,
-	0x92, 0xc8, 0x16, 0x4c, 0x6e, 0x8e, 0x17, 0x91, 0xe5, 0x5a, 0xe8, 0x30, 0x8c, 0x85, 0x9d, 0x61,
-	0xa4, 0x3c, 0xe2, 0x86, 0x5a, 0x0b, 0xb2, 0x52, 0xdb, 0xd7, 0x91, 0x27, 0x63, 0x08, 0x03, 0xb0,
-	0x10, 0x06, 0x1b, 0x0b, 0x63, 0x48, 0xca, 0xe5, 0x83, 0xe0, 0x57, 0x80, 0xed, 0x17, 0x3b, 0x89,
-	0x41, 0x8e, 0x6d, 0xdd, 0xaa, 0x55, 0x93, 0xd4, 0x13, 0x15, 0x70, 0x5c, 0x1e, 0x99, 0x91, 0xcb,
-	0x11, 0x0f, 0xbd, 0xd0, 0xa1, 0x9e, 0x8d, 0x35, 0x57, 0x43, 0xdd, 0xd8, 0x9a, 0x73, 0x2e, 0x19,
-	0x03, 0x59, 0x6d, 0x75, 0x60, 0x33, 0x79, 0x96, 0xf1, 0x59, 0x10, 0x67, 0x53, 0x6a, 0x2e, 0x9a,
-	0x52, 0x43, 0xcb, 0x5e, 0xb0, 0x20, 0x4e, 0xa7, 0xf5, 0x3b, 0xd8, 0x1a, 0x46, 0xe1, 0x0d, 0x0b,
-	0xf4, 0x31, 0xb5, 0xc5, 0x34, 0x62, 0x7c, 0x1a, 0x7a, 0x23, 0xec, 0xdf, 0x96, 0xad, 0x4d, 0xc5,
-	0x56, 0x67, 0x75, 0x90, 0x30, 0x49, 0x07, 0x9a, 0x85, 0xdc, 0x2b, 0xd9, 0x92, 0xd6, 0xe2, 0xd6,
-	0x13, 0xc9, 0xa5, 0x62, 0x89, 0xf3, 0x2f, 0x61, 0x6b, 0xca, 0xa8, 0x27, 0xa6, 0x36, 0x0d, 0xa8,
-	0x77, 0xc7, 0x5d, 0x9e, 0x5a, 0xd9, 0x42, 0x2b, 0xad, 0xfd, 0x53, 0xe4, 0x77, 0x34, 0x3b, 0xdd,
-	0xcc, 0xe9, 0x22, 0x72, 0xfb, 0x7f, 0x2b, 0x60, 0x7e, 0x28, 0xa6, 0xc8, 0xeb, 0x8f, 0xbd, 0x59,
-	0xa8, 0xb4, 0xe0, 0x43, 0xef, 0x15, 0x2f, 0x3f, 0xf4, 0x5e, 0xa1, 0x32, 0xde, 0x45, 0x6f, 0x15,
-	0x5f, 0x7f, 0xf8, 0x09, 0x40, 0x61, 0xff, 0xe2, 0xf6, 0xff, 0xcf, 0xf4, 0xd6, 0x96, 0x3e, 0xde,
-	0x5b, 0xc3, 0xe7, 0x3b, 0xf5, 0x62, 0xb0, 0x9c, 0x3c, 0xdf, 0xa9, 0x47, 0x82, 0x1d, 0x58, 0xc9,
-	0x1a, 0xfb, 0x0a, 0x57, 0xab, 0xa3, 0xa4, 0x97, 0xff, 0x1c, 0xea, 0x8a, 0x99, 0x3c, 0x1a, 0x3c,
-	0x52, 0xd9, 0x37, 0x12, 0x93, 0x57, 0x82, 0x37, 0xb0, 0xf3, 0x9e, 0xba, 0x62, 0xae, 0xd3, 0xcf,
-	0x54, 0xab, 0xbf, 0xaa, 0x72, 0x43, 0x29, 0x52, 0x6c, 0xf0, 0x77, 0x91, 0x4f, 0x7e, 0xff, 0xd1,
-	0x57, 0x8a, 0x15, 0x1c, 0xf0, 0x43, 0x2f, 0x14, 0xed, 0x9f, 0xca, 0xf0, 0xec, 0x67, 0x4f, 0xb8,
-	0x1c, 0xc2, 0x77, 0x03, 0xd7, 0x97, 0x3b, 0x95, 0xc2, 0x45, 0xba, 0x55, 0x25, 0x8c, 0xe5, 0x2d,
-	0x2d, 0x91, 0x5a, 0xf8, 0x05, 0xfb, 0x55, 0xfe, 0xc8, 0x7e, 0xe5, 0x3c, 0x5e, 0x29, 0x7a, 0xfc,
-	0x67, 0xfc, 0xb5, 0xf4, 0x57, 0xf9, 0x6b, 0xf9, 0xe3, 0xfe, 0xba, 0x80, 0xb5, 0xd4, 0x5d, 0x1f,
-	0x7e, 0x8d, 0xfd, 0x14, 0xd6, 0x33, 0xd0, 0x53, 0x3d, 0xbb, 0x32, 0x56, 0x64, 0x6b, 0x29, 0x19,
-	0x41, 0xbc, 0xfd, 0x1f, 0x25, 0xa8, 0x17, 0x9a, 0x65, 0xe4, 0x73, 0x58, 0xcd, 0xd2, 0x89, 0xe4,
-	0x05, 0x1d, 0xb2, 0x2e, 0x99, 0x05, 0x69, 0x5a, 0xc1, 0xc9, 0x67, 0x00, 0xa9, 0xc1, 0x24, 0x4d,
-	0x82, 0x0c, 0xb1, 0xad, 0x1c, 0x97, 0xfc, 0x3d, 0x18, 0xd9, 0x9c, 0xb4, 0x75, 0x95, 0x67, 0xae,
-	0xef, 0x17, 0x97, 0x64, 0x65, 0x93, 0x57, 0xe3, 0xb4, 0x7f, 0x80, 0xcd, 0x85, 0x68, 0x41, 0x5a,
-	0xf0, 0x50, 0x3d, 0x36, 0xe8, 0x5a, 0x4f, 0x7f, 0xc9, 0x3c, 0x26, 0x79, 0x6f, 0x4e, 0xf0, 0x47,
-	0x9f, 0xe8, 0x35, 0xf5, 0xe0, 0x9c, 0x18, 0x6a, 0xff, 0x6b, 0x09, 0x9a, 0xba, 0xbc, 0x28, 0x3a,
-	0xe2, 0x5b, 0x20, 0x85, 0x7a, 0x46, 0x75, 0xb3, 0x4b, 0x08, 0x5e, 0x39, 0x7f, 0xa8, 0xa7, 0xbb,
-	0x5c, 0xdd, 0xa2, 0x76, 0xa5, 0x9b, 0x55, 0x43, 0xc5, 0x14, 0xbd, 0xac, 0xd1, 0x3b, 0x1f, 0xf4,
-	0x68, 0x23, 0xa9, 0x7d, 0xf2, 0x8c, 0xe1, 0x43, 0xfc, 0x5f, 0x87, 0x57, 0xff, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0xe1, 0x2d, 0x8e, 0x72, 0x27, 0x21, 0x00, 0x00,
+	// 3537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x3a, 0x4d, 0x77, 0x1b, 0x47,
+	0x72, 0x02, 0x48, 0x4a, 0x60, 0x11, 0x20, 0x87, 0x0d, 0x90, 0x1c, 0x91, 0xab, 0x88, 0x82, 0x56,
+	0x6b, 0xae, 0xbd, 0xa1, 0x2d, 0xca, 0x76, 0xac, 0xb5, 0x94, 0x35, 0x48, 0x82, 0x22, 0x2d, 0x7e,
+	0x60, 0x87, 0xe0, 0xe6, 0x79, 0x2f, 0x93, 0x06, 0xd0, 0x04, 0xc6, 0x9c, 0x0f, 0xec, 0x74, 0x8f,
+	0x25, 0xfe, 0x83, 0xfc, 0x87, 0xe4, 0x98, 0xe4, 0x90, 0xf7, 0xf6, 0x6f, 0xe4, 0x90, 0x4b, 0x4e,
+	0xf9, 0x3f, 0x79, 0x55, 0xdd, 0x33, 0x98, 0x21, 0x20, 0xd9, 0x79, 0x39, 0x61, 0xba, 0xbe, 0xba,
+	0xbb, 0xba, 0xaa, 0xba, 0xaa, 0x1a, 0x50, 0xed, 0x47, 0xe1, 0xb5, 0x37, 0xdc, 0x1d, 0xc7, 0x91,
+	0x8a, 0x36, 0x3f, 0x1d, 0xf7, 0x3e, 0xef, 0x27, 0x52, 0x45, 0x81, 0x2b, 0x7e, 0xe2, 0x7e, 0xc2,
+	0x55, 0x14, 0x4f, 0x01, 0x34, 0x6d, 0xf3, 0x5f, 0xca, 0xb0, 0xdc, 0x15, 0x52, 0x9d, 0xf3, 0x40,
+	0x1c, 0x90, 0x10, 0xf6, 0x1d, 0xd4, 0x42, 0x1e, 0x08, 0x57, 0xf8, 0x22, 0x10, 0xa1, 0x92, 0x76,
+	0x69, 0x7b, 0x6e, 0x67, 0x69, 0x6f, 0x6b, 0xb7, 0x48, 0xb7, 0x8b, 0x9f, 0x6d, 0x4d, 0xe3, 0x54,
+	0xc3, 0xc9, 0x40, 0xb2, 0xc7, 0xb0, 0x44, 0x12, 0xae, 0xa3, 0x38, 0xe0, 0xca, 0x2e, 0x6f, 0x97,
+	0x76, 0x16, 0x1d, 0x40, 0xd0, 0x11, 0x41, 0x36, 0xff, 0xbd, 0x04, 0x4b, 0x39, 0x76, 0xb6, 0x0e,
+	0xf7, 0x7d, 0xde, 0x13, 0x3e, 0xce, 0x85, 0xb4, 0x66, 0xc4, 0x9e, 0x42, 0x4d, 0xf1, 0x78, 0x28,
+	0x94, 0xab, 0x37, 0x68, 0x44, 0x55, 0x35, 0xd0, 0xac, 0xf7, 0x09, 0x54, 0x7b, 0x89, 0xe7, 0x0f,
+	0x5c, 0x0d, 0xb5, 0xe7, 0xb6, 0x4b, 0x3b, 0x15, 0x67, 0x89, 0x60, 0x5d, 0x02, 0x31, 0x06, 0xf3,
+	0x8a, 0x0f, 0xa5, 0x3d, 0x4f, 0xec, 0xf4, 0x4d, 0xb2, 0x85, 0x54, 0xee, 0x38, 0x8e, 0xc6, 0x22,
+	0x56, 0xb7, 0xf6, 0x82, 0x91, 0x2d, 0xa4, 0xea, 0x18, 0x58, 0xf3, 0x2d, 0x54, 0xcf, 0x23, 0xe5,
+	0x5d, 0x7b, 0x7d, 0xae, 0xbc, 0x28, 0x64, 0x36, 0x3c, 0x90, 0x49, 0x10, 0xf0, 0xf8, 0xd6, 0xac,
+	0x34, 0x1d, 0xe2, 0x2a, 0xfa, 0x51, 0xa8, 0xc4, 0x7b, 0xe5, 0xfa, 0x5e, 0x78, 0x63, 0x56, 0xba,
+	0x64, 0x60, 0xa7, 0x5e, 0x78, 0xd3, 0xfc, 0xb7, 0x27, 0xb0, 0x88, 0x3a, 0x7c, 0x13, 0x47, 0xc9,
+	0x18, 0xd7, 0x84, 0x1a, 0x31, 0x72, 0xe8, 0x9b, 0x3d, 0x02, 0x18, 0xf6, 0xa5, 0x3b, 0x8e, 0xc5,
+	0xb5, 0xf7, 0xde, 0x88, 0x58, 0x1c, 0xf6, 0x65, 0x87, 0x00, 0xec, 0x37, 0xb0, 0x32, 0xe0, 0xb7,
+	0xd2, 0x8d, 0xae, 0xdd, 0x58, 0xc8, 0xc4, 0x57, 0x92, 0x36, 0xbb, 0xe0, 0xd4, 0x10, 0x7c, 0x71,
+	0xed, 0x68, 0x20, 0x7b, 0x06, 0xcb, 0xde, 0x30, 0x8c, 0x62, 0xe1, 0x8e, 0x45, 0x38, 0xf0, 0xc2,
+	0x21, 0x6d, 0xbc, 0xe2, 0xd4, 0x34, 0xb4, 0xa3, 0x81, 0xb8, 0x64, 0x43, 0x86, 0xba, 0x52, 0xa4,
+	0x80, 0x8a, 0xb3, 0xa4, 0x61, 0xfb, 0x08, 0x62, 0xdf, 0xc1, 0x2a, 0xea, 0x43, 0xba, 0x74, 0x9e,
+	0xe3, 0xc8, 0xf7, 0xfa, 0xb7, 0xf6, 0xfd, 0xed, 0xd2, 0xce, 0xf2, 0x5e, 0x63, 0x37, 0xdb, 0x0b,
+	0x7d, 0x49, 0x3c, 0x50, 0x67, 0x45, 0xa5, 0x9f, 0x1d, 0x22, 0x66, 0xdf, 0xc0, 0xfa, 0x90, 0xab,
+	0x91, 0x88, 0xdd, 0xbc, 0xb6, 0x3d, 0x21, 0xed, 0x07, 0x38, 0xdd, 0x7e, 0xd9, 0x2e, 0x39, 0x0d,
+	0x4d, 0xd1, 0x9d, 0x68, 0xde, 0x13, 0x92, 0xed, 0xc1, 0x9a, 0x59, 0x1e, 0x71, 0xca, 0xa4, 0x27,
+	0x55, 0x8c, 0x9b, 0xa9, 0x6c, 0xcf, 0xed, 0x2c, 0x3a, 0x75, 0x8d, 0x44, 0xa6, 0xcb, 0x14, 0xc5,
+	0x5e, 0x41, 0xad, 0x1f, 0xf9, 0x49, 0x10, 0xba, 0x23, 0xc1, 0x07, 0x22, 0xb6, 0x17, 0xc9, 0x76,
+	0x37, 0x72, 0x6b, 0x3d, 0x20, 0xfc, 0x31, 0xa1, 0x9d, 0x6a, 0x3f, 0x37, 0x62, 0xc7, 0xb0, 0x7a,
+	0xcd, 0x7d, 0xbf, 0xc7, 0xfb, 0x37, 0xee, 0x10, 0x89, 0x71, 0x36, 0xa0, 0xdd, 0x6e, 0xe5, 0x24,
+	0x1c, 0x19, 0x9a, 0x37, 0x86, 0xc4, 0xb1, 0xae, 0xef, 0x40, 0xd8, 0x6b, 0x78, 0xc8, 0x7d, 0x11,
+	0x2b, 0x57, 0x2a, 0xee, 0x8b, 0xf4, 0xb4, 0xdc, 0x51, 0x94, 0xc4, 0xd2, 0x5e, 0xc2, 0x33, 0xa3,
+	0x8d, 0xaf, 0x13, 0xd1, 0x25, 0xd2, 0x98, 0xb3, 0x3b, 0x46, 0x0a, 0xf6, 0x15, 0xac, 0x85, 0x49,
+	0xe0, 0x5e, 0x73, 0xcf, 0x4f, 0x62, 0x21, 0x5d, 0x15, 0xb9, 0x44, 0x69, 0x57, 0x33, 0x56, 0x16,
+	0x26, 0xc1, 0x91, 0xc1, 0x77, 0xa3, 0x16, 0x62, 0xd1, 0xa4, 0x7b, 0xc9, 0xd0, 0xed, 0x47, 0xc1,
+	0x38, 0x0a, 0x45, 0xa8, 0xec, 0x1a, 0x59, 0x47, 0xb5, 0x97, 0x0c, 0x0f, 0x52, 0x18, 0xdb, 0x01,
+	0xab, 0x1f, 0x0d, 0x84, 0x2b, 0x05, 0x8f, 0xfb, 0x23, 0x77, 0xcc, 0xd5, 0xc8, 0x5e, 0x26, 0x4b,
+	0x5b, 0x46, 0xf8, 0x25, 0x81, 0x3b, 0x5c, 0x8d, 0xd8, 0xef, 0x00, 0x27, 0x71, 0xb5, 0x8a, 0xa4,
+	0x1b, 0x8b, 0x3e, 0xca, 0x5c, 0x21, 0x99, 0x56, 0x98, 0x04, 0x5a, 0x93, 0xd2, 0x21, 0x38, 0xfb,
+	0x14, 0x56, 0x13, 0x69, 0xce, 0x2a, 0x10, 0x8a, 0x0f, 0xb8, 0xe2, 0xb6, 0x45, 0x26, 0xb5, 0x92,
+	0x48, 0x3a, 0xa7, 0x33, 0x03, 0x66, 0x2f, 0x61, 0x43, 0xab, 0x27, 0xe0, 0x9e, 0x4f, 0xbb, 0x1b,
+	0x0c, 0x62, 0x21, 0xa5, 0x90, 0xf6, 0x2a, 0x2e, 0x45, 0x5b, 0x05, 0x91, 0x9c, 0x71, 0xcf, 0xef,
+	0x46, 0xad, 0x14, 0xcf, 0xbe, 0x00, 0x96, 0x63, 0x95, 0x49, 0xef, 0x47, 0xd1, 0x57, 0x36, 0xcb,
+	0xb8, 0xac, 0x8c, 0xeb, 0x52, 0xe3, 0xd8, 0x1f, 0x60, 0x33, 0xc7, 0x61, 0x74, 0xea, 0x06, 0x42,
+	0x4a, 0x3e, 0x14, 0x76, 0x3d, 0xe3, 0xdc, 0xc8, 0x38, 0x8d, 0x5e, 0xcf, 0x34, 0x09, 0x7b, 0x01,
+	0x8d, 0x9c, 0x80, 0x81, 0x40, 0x1d, 0x27, 0xb1, 0x6f, 0x37, 0x32, 0xd6, 0xd5, 0x8c, 0xf5, 0x10,
+	0xb1, 0x57, 0xb1, 0xcf, 0x4e, 0xe1, 0x49, 0xe0, 0x85, 0xae, 0xf0, 0xf9, 0x58, 0x8a, 0x81, 0x1b,
+	0x78, 0x61, 0xa2, 0x84, 0x74, 0x7b, 0x42, 0xbd, 0x13, 0x22, 0x24, 0x51, 0xd2, 0x5e, 0xcb, 0x8e,
+	0xf3, 0x51, 0xe0, 0x85, 0x6d, 0x4d, 0x7b, 0xa6, 0x49, 0xf7, 0x35, 0x25, 0x0a, 0x95, 0xec, 0x07,
+	0xd8, 0x41, 0xe5, 0xea, 0x28, 0x98, 0xc4, 0x14, 0x8c, 0x5c, 0x0c, 0xe5, 0x42, 0xba, 0x5c, 0x6a,
+	0xe3, 0x70, 0xc7, 0x3c, 0xe6, 0x81, 0xb4, 0xd7, 0x33, 0xbf, 0x7a, 0x9a, 0x48, 0x71, 0x90, 0x67,
+	0xf9, 0x13, 0x71, 0xb4, 0x24, 0x99, 0x4b, 0x87, 0xc8, 0xd9, 0x2e, 0xd4, 0x45, 0xc8, 0x7b, 0xbe,
+	0x70, 0xaf, 0x7d, 0x7e, 0x73, 0x8b, 0x16, 0xab, 0x12, 0x69, 0x6f, 0xd0, 0xc9, 0xad, 0x6a, 0xd4,
+	0x11, 0x62, 0x2e, 0x09, 0x81, 0x6e, 0x89, 0x4b, 0xb9, 0x49, 0x7a, 0x22, 0x0e, 0x05, 0xee, 0xa9,
+	0xef, 0x7b, 0x68, 0x18, 0x36, 0x71, 0xd4, 0x13, 0x29, 0xde, 0x66, 0xb8, 0x03, 0x42, 0xe1, 0x85,
+	0xe0, 0x49, 0x57, 0xbc, 0x57, 0x22, 0x0e, 0xb9, 0x6f, 0x3f, 0x24, 0x4a, 0xf0, 0x64, 0xdb, 0x40,
+	0xd8, 0x4b, 0xb0, 0xc8, 0x70, 0x28, 0xcc, 0x98, 0x58, 0xbf, 0xb9, 0x5d, 0xda, 0x59, 0xda, 0x5b,
+	0xb9, 0x73, 0xed, 0x38, 0xcb, 0xaa, 0x30, 0x66, 0x2f, 0xa0, 0x16, 0xe6, 0x42, 0xb4, 0xb4, 0xb7,
+	0xc8, 0xe5, 0x6b, 0xbb, 0xf9, 0xc0, 0xed, 0x14, 0x69, 0xd8, 0x6b, 0x58, 0x36, 0x71, 0x42, 0x46,
+	0xb1, 0x72, 0x7b, 0xb7, 0xf6, 0xaf, 0xc8, 0xcd, 0xa7, 0x03, 0xc5, 0x65, 0x14, 0xab, 0xfd, 0xdb,
+	0x34, 0x50, 0xe8, 0x11, 0x6b, 0x83, 0x35, 0x8e, 0x3d, 0x8c, 0xfb, 0x93, 0x38, 0xf1, 0x88, 0x04,
+	0x6c, 0xe6, 0x04, 0x74, 0x34, 0x49, 0x16, 0x26, 0x56, 0xc6, 0x45, 0x40, 0x4e, 0xf5, 0xa9, 0xd7,
+	0x8c, 0xa2, 0x81, 0xb4, 0xff, 0x26, 0xaf, 0x7a, 0xe3, 0x37, 0x88, 0x60, 0x87, 0x46, 0x4b, 0x3c,
+	0x0c, 0x23, 0x65, 0x76, 0xfb, 0x98, 0x76, 0xfb, 0xf0, 0x4e, 0x30, 0x6e, 0x65, 0x14, 0x3a, 0x22,
+	0x4f, 0xc6, 0x92, 0x7d, 0x03, 0x0f, 0x03, 0xfe, 0xbe, 0x30, 0xa5, 0x3b, 0x36, 0xf1, 0xd9, 0xde,
+	0x26, 0xef, 0x5e, 0x0b, 0xf8, 0xfb, 0xdc, 0xc4, 0x1d, 0x1d, 0x9b, 0x59, 0x0b, 0x1e, 0xf5, 0xa3,
+	0x20, 0xf0, 0x94, 0x1b, 0xfd, 0x24, 0xe2, 0xd8, 0x1b, 0x08, 0x97, 0x2e, 0x6a, 0x0c, 0x22, 0x78,
+	0x90, 0xf6, 0x13, 0x8a, 0x23, 0x9b, 0x9a, 0xe8, 0xc2, 0xd0, 0x9c, 0x22, 0x49, 0x47, 0x53, 0xb0,
+	0x63, 0x58, 0x2b, 0x44, 0x08, 0x37, 0x1a, 0xeb, 0x7d, 0x34, 0x69, 0x1f, 0x8d, 0xdd, 0x7c, 0x9c,
+	0xb8, 0xd0, 0x38, 0xa7, 0xae, 0xa6, 0x81, 0x18, 0xc7, 0x48, 0x92, 0xe2, 0xc3, 0x6c, 0xfe, 0xa7,
+	0x3a, 0x8e, 0x21, 0xbc, 0xcb, 0x87, 0xe9, 0x9c, 0x2f, 0xc1, 0xe2, 0x89, 0x8a, 0x5c, 0xf4, 0xdb,
+	0x74, 0xba, 0x5f, 0x1b, 0xe3, 0x6a, 0x25, 0x2a, 0xda, 0x4f, 0x86, 0xe9, 0x4c, 0xcb, 0xbc, 0x30,
+	0x66, 0x2f, 0x60, 0x3d, 0xd3, 0x55, 0x9c, 0x84, 0xca, 0x0b, 0x84, 0x09, 0xe2, 0xcf, 0x48, 0x51,
+	0x75, 0xa3, 0x28, 0x47, 0xe3, 0x74, 0xf4, 0x7e, 0x05, 0x5b, 0x18, 0x37, 0xc7, 0x5c, 0x4a, 0x1d,
+	0xbb, 0x07, 0x9e, 0xa4, 0x53, 0xd6, 0x31, 0xfc, 0x37, 0xc4, 0xb9, 0x11, 0x26, 0x41, 0x87, 0x28,
+	0xba, 0xd1, 0xa1, 0xc6, 0xeb, 0x20, 0xfe, 0x19, 0x30, 0x4c, 0x20, 0x70, 0xb5, 0xd2, 0xed, 0x19,
+	0x03, 0xb3, 0x3f, 0xd1, 0x81, 0x14, 0x31, 0xfb, 0xc9, 0x50, 0xee, 0x6b, 0x23, 0x62, 0x27, 0xd0,
+	0x10, 0xe1, 0x4f, 0x5e, 0x1c, 0x85, 0x98, 0x47, 0xb9, 0x5e, 0x28, 0x15, 0x0f, 0xfb, 0xc2, 0xde,
+	0x21, 0x63, 0x5c, 0xcf, 0x59, 0x45, 0x7b, 0x42, 0xe6, 0xd4, 0x73, 0x3c, 0x27, 0x86, 0x85, 0x9d,
+	0xc0, 0x7a, 0xce, 0x24, 0xf2, 0x17, 0xf5, 0x6f, 0xe9, 0x68, 0xea, 0x39, 0x61, 0x6f, 0xc5, 0x2d,
+	0x85, 0x12, 0xa7, 0xa1, 0x32, 0x2b, 0xc9, 0xdd, 0xdc, 0x8f, 0x61, 0xc9, 0xdc, 0xf9, 0xb8, 0x09,
+	0xfb, 0x53, 0xed, 0xee, 0x1a, 0x84, 0xab, 0xc7, 0xbb, 0x42, 0x8e, 0xd0, 0xf1, 0x28, 0x5f, 0x0a,
+	0x84, 0x8a, 0xbd, 0xbe, 0xfd, 0x19, 0x1d, 0xde, 0x0a, 0x21, 0xba, 0xe2, 0x3d, 0x8a, 0x8d, 0xbd,
+	0x3e, 0x3b, 0x83, 0xa7, 0x77, 0x8d, 0x6e, 0x46, 0x18, 0xb4, 0x7f, 0x47, 0xdc, 0xdb, 0x45, 0xd3,
+	0x9b, 0x0e, 0x7e, 0x68, 0xfd, 0x05, 0xf5, 0x16, 0x3c, 0xef, 0x6f, 0x69, 0xa5, 0x6b, 0x13, 0x2d,
+	0xe7, 0xbd, 0xef, 0x2b, 0xd8, 0xc8, 0x2b, 0x28, 0xe0, 0xaa, 0x3f, 0x72, 0x63, 0x31, 0x14, 0xef,
+	0xed, 0x5d, 0x9a, 0x3c, 0xa7, 0x8c, 0x33, 0x44, 0x3a, 0x88, 0x63, 0xcf, 0x75, 0xbc, 0xbc, 0x4e,
+	0x7c, 0x3f, 0x65, 0xc5, 0x28, 0x27, 0xed, 0xcf, 0x69, 0x32, 0x96, 0x48, 0x71, 0x94, 0xf8, 0xbe,
+	0xe6, 0xc3, 0xb8, 0x26, 0x59, 0x1b, 0x1e, 0x99, 0x74, 0x5d, 0x27, 0x0e, 0x93, 0xac, 0xdd, 0x8d,
+	0x13, 0x5f, 0x48, 0xfb, 0x0b, 0xcc, 0x80, 0x28, 0xc4, 0x6f, 0x6a, 0x42, 0x9d, 0x3d, 0xb4, 0x53,
+	0x32, 0x07, 0xa9, 0xd8, 0x1f, 0xe1, 0xd9, 0x54, 0x3a, 0x33, 0x53, 0x77, 0xcf, 0x69, 0xf9, 0xcd,
+	0xbb, 0x59, 0xcc, 0x0c, 0xed, 0xbd, 0x82, 0x9a, 0x59, 0x92, 0x8c, 0x92, 0xb8, 0x2f, 0xec, 0x3d,
+	0xf2, 0xa3, 0x7c, 0xd8, 0xd4, 0x4b, 0xb9, 0x24, 0xb4, 0x53, 0x8d, 0x73, 0x23, 0x76, 0x00, 0x0f,
+	0xef, 0x96, 0x21, 0xb4, 0x21, 0x57, 0x0a, 0x65, 0xbf, 0x20, 0x49, 0x95, 0x5d, 0x5c, 0xfb, 0xa5,
+	0x50, 0xce, 0xba, 0x26, 0x2d, 0xec, 0xe9, 0x52, 0x28, 0x3c, 0x86, 0x58, 0xf0, 0x01, 0xdd, 0x53,
+	0xc2, 0xbd, 0x8e, 0xa3, 0xc0, 0x95, 0x2a, 0x8a, 0xf1, 0x2e, 0xff, 0x92, 0x34, 0xda, 0x40, 0x34,
+	0x5e, 0x56, 0xe2, 0x28, 0x8e, 0x82, 0x4b, 0x8d, 0xc3, 0x64, 0xc6, 0x64, 0x93, 0x91, 0x3f, 0xc8,
+	0xd2, 0xe7, 0xaf, 0x88, 0xc3, 0xd2, 0x98, 0x0b, 0x7f, 0x90, 0x66, 0xd0, 0x78, 0x61, 0x69, 0x6a,
+	0x79, 0xe3, 0x8d, 0xed, 0xaf, 0xcd, 0x85, 0x45, 0xa0, 0xcb, 0x1b, 0x6f, 0xcc, 0xbe, 0x01, 0xfb,
+	0xae, 0x55, 0x4a, 0x15, 0x5f, 0x63, 0x10, 0xb0, 0xff, 0x8e, 0xd4, 0xb9, 0x5e, 0x34, 0xc5, 0x4b,
+	0x83, 0xc5, 0x24, 0x2d, 0x91, 0x22, 0x9e, 0xd4, 0x1d, 0xdf, 0xe8, 0xba, 0x03, 0x81, 0x69, 0xdd,
+	0x91, 0xc6, 0x9d, 0x34, 0xf5, 0xc2, 0xf0, 0x9c, 0x8c, 0x07, 0x5c, 0x09, 0xfb, 0x65, 0x16, 0x77,
+	0x4c, 0xfa, 0xd5, 0x11, 0xf1, 0x15, 0xa1, 0xd8, 0x67, 0xb0, 0xaa, 0x5d, 0x29, 0xef, 0xbc, 0xbf,
+	0xa7, 0x64, 0xd9, 0xd2, 0x88, 0x9c, 0x8f, 0x7e, 0x0d, 0x1b, 0x32, 0xf1, 0x94, 0x70, 0xa7, 0x59,
+	0xbe, 0xd5, 0x5e, 0x40, 0xe8, 0xb3, 0xbb, 0x7c, 0xcf, 0x60, 0x39, 0x16, 0x63, 0xf4, 0x5d, 0x5d,
+	0xdd, 0x49, 0xfb, 0x15, 0xcd, 0x50, 0xd3, 0x50, 0x5d, 0xe0, 0xc9, 0xcd, 0xbf, 0x40, 0x35, 0x9f,
+	0x68, 0xb3, 0x06, 0x2c, 0xd0, 0x55, 0x61, 0xca, 0x1d, 0x3d, 0x60, 0x9b, 0x50, 0xc9, 0xd4, 0xa0,
+	0xab, 0x9d, 0x6c, 0xcc, 0x3e, 0x87, 0xfa, 0x2c, 0x5b, 0x9d, 0x23, 0x32, 0xd6, 0x9f, 0xb2, 0xcd,
+	0x4d, 0xa9, 0x2b, 0xd9, 0xc9, 0x55, 0x87, 0xe5, 0xd4, 0x24, 0xcc, 0x98, 0x99, 0x17, 0xb3, 0xf8,
+	0xc2, 0x9e, 0x41, 0x2d, 0x9d, 0x8d, 0x5c, 0x52, 0x2f, 0xe1, 0xf8, 0x9e, 0x53, 0x4d, 0xc1, 0xe8,
+	0x8e, 0xfb, 0x5b, 0xf0, 0xb0, 0x10, 0xac, 0x28, 0x29, 0x34, 0xf6, 0xbf, 0xb9, 0x07, 0x95, 0x34,
+	0x18, 0x32, 0x0b, 0xe6, 0x6e, 0x44, 0x5a, 0x18, 0xe2, 0x27, 0xee, 0x5a, 0xaf, 0x5a, 0x6f, 0x4e,
+	0x0f, 0x36, 0xff, 0xa3, 0x04, 0xd5, 0xbc, 0x97, 0xb0, 0xe7, 0x50, 0xfd, 0x31, 0x09, 0xbd, 0x42,
+	0x95, 0xbb, 0xb4, 0x57, 0xdd, 0xfd, 0xfe, 0x2a, 0xf4, 0x4c, 0x95, 0x7b, 0x7c, 0xcf, 0x59, 0xfa,
+	0x31, 0xc9, 0x86, 0xec, 0x00, 0x98, 0xb1, 0x61, 0x85, 0x56, 0x6a, 0x18, 0xe7, 0x89, 0x91, 0xa5,
+	0x3e, 0x88, 0xa8, 0x8c, 0x7d, 0x35, 0x47, 0xaf, 0x81, 0xfb, 0xeb, 0xd0, 0x28, 0x78, 0xb3, 0x11,
+	0xf3, 0xfd, 0x7c, 0xa5, 0x64, 0x95, 0xbf, 0x9f, 0xaf, 0xcc, 0x59, 0xf3, 0xcd, 0x40, 0xd7, 0xac,
+	0x54, 0xd2, 0xb1, 0x4d, 0x58, 0xef, 0xb6, 0x2f, 0xbb, 0x97, 0xee, 0x79, 0xeb, 0xac, 0xed, 0x5e,
+	0x9d, 0x5f, 0x76, 0xda, 0x07, 0x27, 0x47, 0x27, 0xed, 0x43, 0xeb, 0x1e, 0x5b, 0x83, 0xd5, 0x1c,
+	0xee, 0xe4, 0xcd, 0xf9, 0x85, 0xd3, 0xb6, 0x4a, 0x6c, 0x1d, 0x58, 0x0e, 0xec, 0xb4, 0x3b, 0xa7,
+	0xad, 0x83, 0xb6, 0x55, 0xbe, 0x43, 0xde, 0xea, 0x74, 0xda, 0xe7, 0x87, 0xd6, 0x5c, 0xf3, 0xbf,
+	0x4b, 0x60, 0xdd, 0xad, 0xaf, 0x70, 0xda, 0xa3, 0xd6, 0xe9, 0xe9, 0x7e, 0xeb, 0xe0, 0xad, 0xfb,
+	0xc6, 0xb9, 0xb8, 0xea, 0x9c, 0x9c, 0xbf, 0x71, 0xcf, 0x2f, 0xce, 0xdb, 0xd6, 0xbd, 0xd9, 0xb8,
+	0xc3, 0x56, 0x17, 0xe7, 0xfe, 0x15, 0xd8, 0xd3, 0xb8, 0xd3, 0xd6, 0x7e, 0xfb, 0xf4, 0xd2, 0x2a,
+	0x33, 0x1b, 0x1a, 0xd3, 0xd8, 0x93, 0x43, 0x6b, 0x8e, 0x6d, 0xc3, 0xaf, 0xa6, 0x31, 0x07, 0x17,
+	0x67, 0x67, 0x27, 0x5d, 0xf7, 0xfc, 0xea, 0xcc, 0x9a, 0x67, 0xbf, 0x85, 0x67, 0xb3, 0x28, 0xce,
+	0x8f, 0x4e, 0xde, 0x5c, 0x39, 0xad, 0xee, 0xc9, 0xc5, 0xb9, 0xfb, 0xa7, 0xd6, 0xe9, 0x55, 0xdb,
+	0x5a, 0x68, 0x7e, 0x97, 0x7a, 0x82, 0xc9, 0x1d, 0x1b, 0x60, 0x1d, 0x5c, 0x9c, 0x5e, 0x9d, 0x9d,
+	0xbb, 0x97, 0x17, 0x4e, 0x57, 0x2f, 0x95, 0xb6, 0x91, 0x87, 0xe6, 0x26, 0x2b, 0x35, 0xcf, 0x60,
+	0xe5, 0x4e, 0x2a, 0xc9, 0x1e, 0xc2, 0x5a, 0xc7, 0x39, 0x39, 0x6b, 0x39, 0x3f, 0x4c, 0x29, 0xe4,
+	0x31, 0x6c, 0x4d, 0xa1, 0x0a, 0xe2, 0x1e, 0xc3, 0x52, 0x2e, 0x19, 0x60, 0x15, 0x98, 0xef, 0x38,
+	0x17, 0x78, 0x82, 0xf7, 0xa1, 0xfc, 0xc7, 0x96, 0x55, 0x6a, 0xd6, 0x60, 0x29, 0x67, 0x79, 0xcd,
+	0x03, 0x58, 0x9d, 0xb2, 0x27, 0x6c, 0x84, 0x8c, 0xe3, 0x88, 0x6a, 0x2f, 0xd3, 0x08, 0x31, 0x43,
+	0xb4, 0xf9, 0xbf, 0x24, 0x22, 0x4e, 0x1d, 0x5a, 0x0f, 0x9a, 0x7f, 0x2d, 0x41, 0x7d, 0x46, 0x6a,
+	0x87, 0x2d, 0x8d, 0x49, 0xe2, 0xaf, 0x2f, 0x53, 0x2d, 0xaf, 0x96, 0xa6, 0xf9, 0xfa, 0x16, 0x9d,
+	0x2a, 0x6d, 0xcb, 0x33, 0x4a, 0xdb, 0x06, 0x2c, 0x44, 0xef, 0x42, 0x11, 0x9b, 0x20, 0xa1, 0x07,
+	0x6c, 0x19, 0xca, 0xfd, 0xbe, 0x3d, 0x4f, 0x51, 0xaa, 0xdc, 0xef, 0xa3, 0xa8, 0xd4, 0x89, 0xf5,
+	0x84, 0xa6, 0xf1, 0x63, 0x80, 0x34, 0x5f, 0xf3, 0xbf, 0x16, 0x60, 0xb9, 0x98, 0x1b, 0xb2, 0x2f,
+	0x61, 0xbd, 0x27, 0x14, 0x77, 0x79, 0xa2, 0xa2, 0xe2, 0x5a, 0x80, 0xd6, 0xd2, 0x40, 0x6c, 0x4b,
+	0x23, 0x27, 0x6b, 0x7a, 0x04, 0x80, 0x0c, 0x6e, 0xdf, 0x8f, 0xa4, 0x6e, 0xf6, 0x54, 0x9c, 0x45,
+	0x84, 0x1c, 0x20, 0x00, 0x2f, 0x9a, 0x51, 0xa4, 0x7c, 0x4f, 0x2a, 0xd7, 0x1b, 0x48, 0xbb, 0xbc,
+	0x3d, 0xb7, 0x33, 0xe7, 0x80, 0x01, 0x9d, 0x0c, 0x70, 0xd6, 0xca, 0x38, 0xf6, 0xa2, 0xd8, 0x53,
+	0xb7, 0xb4, 0xad, 0xe5, 0x3d, 0xfb, 0x4e, 0xd2, 0xba, 0xdb, 0x31, 0x78, 0x27, 0xa3, 0x64, 0x6f,
+	0x61, 0x23, 0x27, 0xd6, 0xdc, 0x92, 0xfa, 0xc6, 0x9e, 0x37, 0x89, 0xf6, 0x71, 0x3a, 0x07, 0xdd,
+	0x92, 0x84, 0x73, 0x1a, 0x93, 0x89, 0x27, 0x50, 0xf6, 0x09, 0xac, 0x5c, 0x7b, 0xbe, 0x70, 0xbd,
+	0x70, 0xe0, 0xfd, 0xe4, 0x0d, 0x12, 0xee, 0x9b, 0x56, 0xd1, 0x32, 0x82, 0x4f, 0x32, 0x28, 0x5e,
+	0x40, 0xd2, 0x0b, 0x87, 0xbe, 0x50, 0x51, 0x98, 0xaa, 0x89, 0xba, 0x45, 0x15, 0xc7, 0xca, 0x10,
+	0x46, 0x43, 0xec, 0x35, 0x6c, 0xe1, 0x15, 0xc7, 0x7d, 0x3f, 0x7a, 0x27, 0x06, 0x39, 0xe1, 0x3a,
+	0x69, 0x7c, 0x40, 0x3a, 0xb5, 0x03, 0xfe, 0xbe, 0xa5, 0x29, 0x26, 0xf3, 0x50, 0x0a, 0xf9, 0x04,
+	0xaa, 0xb4, 0x28, 0xbc, 0x7e, 0xb9, 0xef, 0xdb, 0x15, 0xdd, 0xbc, 0x42, 0xd8, 0x85, 0x06, 0xb1,
+	0x7f, 0x80, 0xb5, 0x81, 0xb8, 0xe6, 0x18, 0xdf, 0x8a, 0x5d, 0x89, 0x45, 0x0a, 0x93, 0x4f, 0xef,
+	0xea, 0xf1, 0x50, 0x13, 0xe7, 0xcd, 0xd4, 0xa9, 0x0f, 0xa6, 0x81, 0x9b, 0xff, 0x08, 0xf5, 0x19,
+	0xb4, 0xd3, 0x36, 0x5a, 0xfa, 0x98, 0x8d, 0x96, 0xa7, 0x6d, 0x54, 0x9b, 0x6d, 0xb9, 0xdf, 0x6f,
+	0x9e, 0x42, 0x25, 0x3d, 0x55, 0x8c, 0x53, 0x1d, 0xe7, 0xe4, 0xc2, 0x39, 0xe9, 0xfe, 0x70, 0x27,
+	0xe4, 0xde, 0x87, 0x72, 0xe7, 0x0b, 0xab, 0x44, 0xbf, 0xcf, 0xad, 0x32, 0xfd, 0xee, 0x59, 0x73,
+	0xf4, 0xfb, 0xc2, 0x9a, 0xa7, 0xdf, 0x2f, 0xad, 0x85, 0xe6, 0x9f, 0xa1, 0x3e, 0xe3, 0xb4, 0xd9,
+	0x7a, 0x7a, 0x3b, 0xe1, 0x3a, 0xe7, 0x8e, 0xef, 0x99, 0xfb, 0x09, 0xe1, 0xfa, 0xae, 0x4e, 0xef,
+	0x43, 0x3d, 0xdc, 0xaf, 0xc3, 0xea, 0xc4, 0xa8, 0x8c, 0x39, 0x35, 0xff, 0xb3, 0x0c, 0x8b, 0x87,
+	0x5c, 0x8e, 0x7a, 0x11, 0x8f, 0x07, 0x6c, 0x0f, 0x6a, 0x83, 0x74, 0xe0, 0x2a, 0xde, 0x33, 0xbd,
+	0xe3, 0xda, 0x6e, 0x46, 0xd2, 0xe5, 0x3d, 0xa7, 0x3a, 0xc8, 0x8d, 0xb2, 0x46, 0x68, 0x39, 0xd7,
+	0x08, 0x9d, 0x2a, 0xea, 0xe7, 0x7e, 0x41, 0x51, 0xff, 0x18, 0x96, 0xb2, 0xf3, 0xe6, 0x3d, 0xe3,
+	0xd6, 0x90, 0x1e, 0x20, 0xef, 0x61, 0xeb, 0x62, 0x10, 0xbd, 0x0b, 0xc7, 0x3e, 0xbf, 0xa5, 0x3e,
+	0x10, 0xe6, 0xc3, 0x8a, 0xf7, 0xa4, 0x31, 0x9e, 0x7a, 0x8a, 0x3c, 0xd2, 0xb8, 0x2e, 0xef, 0x61,
+	0xb5, 0xbc, 0x3e, 0xf2, 0x86, 0x23, 0xdf, 0x1b, 0x8e, 0x54, 0x91, 0xe9, 0xfe, 0xa4, 0x7f, 0x99,
+	0x51, 0xe4, 0x39, 0x3f, 0x81, 0x95, 0x09, 0xa7, 0x8a, 0x06, 0xfc, 0x56, 0xb7, 0x3c, 0x9d, 0xe5,
+	0x0c, 0xdc, 0x45, 0xe8, 0xf7, 0xf3, 0x95, 0x79, 0x6b, 0xa1, 0x39, 0x80, 0x2a, 0x76, 0x89, 0xbb,
+	0x22, 0x18, 0xfb, 0x5c, 0x51, 0x36, 0x81, 0x4d, 0x26, 0x93, 0x4d, 0x24, 0xb1, 0xcf, 0x76, 0xe1,
+	0x41, 0x5a, 0xbe, 0x96, 0x8d, 0x13, 0x23, 0x87, 0x31, 0xdf, 0x94, 0xd1, 0x49, 0x89, 0x32, 0xc5,
+	0xce, 0x4d, 0x14, 0xdb, 0x7c, 0x0d, 0xf5, 0x19, 0x3c, 0xbf, 0x34, 0x75, 0x69, 0xfe, 0x13, 0x40,
+	0xf5, 0x70, 0xd6, 0xe1, 0xe5, 0xbb, 0xd8, 0x69, 0x4c, 0xa7, 0x9a, 0x23, 0x97, 0x59, 0xe9, 0x98,
+	0x4e, 0x77, 0x18, 0x65, 0x13, 0x53, 0xfe, 0x32, 0xf7, 0x0b, 0xdb, 0x95, 0xf3, 0xff, 0x87, 0x76,
+	0xe5, 0xc2, 0x07, 0xda, 0x95, 0xf8, 0x6a, 0xc0, 0xa5, 0xc8, 0x1a, 0x02, 0xf7, 0x75, 0xbf, 0x1e,
+	0x61, 0x69, 0xc0, 0xff, 0x16, 0x58, 0x34, 0x16, 0xa1, 0x0e, 0x1e, 0xca, 0xa8, 0x8a, 0xce, 0x10,
+	0x2d, 0x31, 0x7f, 0x58, 0x8e, 0x85, 0x84, 0x18, 0x0c, 0x32, 0x8d, 0xbe, 0x84, 0x55, 0x8a, 0x4f,
+	0xb8, 0xc3, 0x8c, 0xb7, 0x32, 0x8b, 0x97, 0x82, 0xeb, 0x7e, 0x32, 0xcc, 0x58, 0x5f, 0x43, 0x9d,
+	0x2b, 0xc5, 0xfb, 0xa3, 0x22, 0xf3, 0xe2, 0x2c, 0xe6, 0x55, 0x4d, 0x99, 0x67, 0x7f, 0x02, 0xd5,
+	0xb4, 0xdf, 0x4c, 0x79, 0x2f, 0xe8, 0x9d, 0x19, 0x18, 0x65, 0xbe, 0x7f, 0x48, 0x13, 0x3f, 0x89,
+	0x8d, 0xcc, 0xc9, 0x14, 0x4b, 0xb3, 0xa6, 0x48, 0x13, 0xcd, 0xab, 0xd8, 0xcf, 0xe6, 0x38, 0x02,
+	0x3b, 0x7f, 0x2a, 0x05, 0x21, 0xd5, 0x59, 0x42, 0xd6, 0x26, 0x87, 0x95, 0x97, 0xb3, 0x8d, 0x2e,
+	0x2b, 0xfb, 0xb1, 0x47, 0x2a, 0xa7, 0x7e, 0xf5, 0xa2, 0x93, 0x07, 0x61, 0x8f, 0x4c, 0xf1, 0x5e,
+	0xe2, 0xf3, 0x58, 0x97, 0xcd, 0xe6, 0xce, 0xd6, 0x1d, 0xeb, 0x55, 0x83, 0xa2, 0xb2, 0x59, 0x27,
+	0x0a, 0x7f, 0x0f, 0x35, 0xdd, 0x0d, 0x4d, 0x0f, 0x76, 0x85, 0x96, 0xf3, 0xb0, 0x10, 0x81, 0xa8,
+	0xd3, 0x92, 0xf6, 0x7c, 0xaa, 0x3c, 0x37, 0x62, 0x7f, 0x86, 0x0d, 0xec, 0x83, 0x7a, 0xa1, 0x90,
+	0xd2, 0x2d, 0x4a, 0xb2, 0x49, 0x52, 0xb3, 0x20, 0xe9, 0x28, 0xa5, 0x2d, 0x88, 0x5c, 0xbb, 0x9e,
+	0x05, 0xc6, 0xbd, 0xf0, 0x5e, 0x94, 0x28, 0x77, 0x12, 0x23, 0xd1, 0xc5, 0x2d, 0xbd, 0x17, 0x42,
+	0x65, 0xb2, 0xb1, 0x87, 0xfc, 0x12, 0x56, 0xc9, 0x00, 0x0b, 0x66, 0xb0, 0x3a, 0xd3, 0x86, 0x90,
+	0x2e, 0x6f, 0x04, 0xbf, 0x06, 0x6a, 0x65, 0xb9, 0xa9, 0x0d, 0x4a, 0x6a, 0x91, 0x57, 0x9c, 0x2a,
+	0x42, 0x8f, 0xb4, 0xc1, 0x49, 0x74, 0x99, 0x81, 0x27, 0x29, 0x1e, 0xfa, 0x51, 0x9f, 0xfb, 0x2e,
+	0xd5, 0xaf, 0x75, 0x7d, 0x63, 0x1b, 0xcc, 0x29, 0x22, 0xba, 0x58, 0xb9, 0xb6, 0x60, 0x2d, 0x7d,
+	0xe2, 0x0a, 0x44, 0x98, 0x4c, 0x96, 0xd4, 0x98, 0xb5, 0xa4, 0xba, 0xa1, 0x3d, 0x13, 0x61, 0x92,
+	0x2d, 0xeb, 0x6b, 0xd8, 0xe8, 0xc5, 0xd1, 0x8d, 0x08, 0x8d, 0x9b, 0xba, 0x6a, 0x14, 0x0b, 0x39,
+	0x8a, 0xfc, 0x01, 0xf5, 0xc2, 0xcb, 0xce, 0x9a, 0x46, 0x6b, 0x5f, 0xed, 0xa6, 0x48, 0xd6, 0x82,
+	0x46, 0x21, 0xf7, 0x4a, 0x8f, 0x64, 0x7d, 0x76, 0x1b, 0x8f, 0xe5, 0x52, 0xb1, 0x54, 0xf9, 0xe7,
+	0xb0, 0x31, 0x12, 0xdc, 0x57, 0x23, 0x97, 0x87, 0xdc, 0xbf, 0x95, 0x9e, 0xcc, 0xa4, 0x6c, 0x90,
+	0x94, 0xf5, 0xdd, 0x63, 0xc2, 0xb7, 0x0c, 0x3a, 0x3b, 0xcc, 0xd1, 0x2c, 0x70, 0xf3, 0x7f, 0xe6,
+	0xc0, 0xfe, 0x90, 0x4d, 0xb1, 0x97, 0x1f, 0x7b, 0xff, 0xd1, 0x69, 0xc1, 0x87, 0xde, 0x7e, 0x9e,
+	0x7f, 0xe8, 0xed, 0x47, 0x67, 0xbc, 0xb3, 0xde, 0x7d, 0xbe, 0xfa, 0xf0, 0x73, 0x8a, 0x8e, 0xfd,
+	0xb3, 0x9f, 0x52, 0x7e, 0xa6, 0x4f, 0x39, 0xff, 0xf1, 0x3e, 0x25, 0x3d, 0x85, 0xea, 0xd7, 0x97,
+	0x85, 0xf4, 0x29, 0x94, 0x86, 0x6c, 0x0b, 0x16, 0x27, 0x8f, 0x24, 0x3a, 0xae, 0x56, 0x06, 0xe9,
+	0xbb, 0xc8, 0x53, 0xa8, 0x69, 0x64, 0xfa, 0x00, 0xf3, 0x40, 0x67, 0xdf, 0x04, 0x4c, 0x5f, 0x5c,
+	0x5e, 0xc3, 0xd6, 0x3b, 0xee, 0xa9, 0xa9, 0x57, 0x13, 0xa1, 0x9f, 0x4d, 0x2a, 0x3a, 0x37, 0x44,
+	0x92, 0xe2, 0x63, 0x49, 0x9b, 0xf0, 0xec, 0xdb, 0x8f, 0xbe, 0xf8, 0x2c, 0xd2, 0x84, 0x1f, 0x7a,
+	0xed, 0x69, 0xfe, 0xb5, 0x0c, 0x4f, 0x7e, 0xd6, 0xc3, 0x71, 0x8a, 0xc0, 0x0b, 0xbd, 0x00, 0x4f,
+	0x2a, 0x25, 0x98, 0x1c, 0x55, 0x89, 0x6c, 0x79, 0xc3, 0x50, 0x64, 0x12, 0x7e, 0xc1, 0x79, 0x95,
+	0x3f, 0x72, 0x5e, 0x39, 0x8d, 0xcf, 0x15, 0x35, 0xfe, 0x33, 0xfa, 0x9a, 0xff, 0x7f, 0xe9, 0x6b,
+	0xe1, 0xe3, 0xfa, 0x3a, 0x83, 0xe5, 0x4c, 0x5d, 0x1f, 0x7e, 0xd9, 0xfe, 0x04, 0x9f, 0xae, 0x0d,
+	0x95, 0xe9, 0x7f, 0x96, 0xa9, 0x22, 0x5b, 0xce, 0xc0, 0x14, 0xc4, 0x9b, 0xff, 0x5a, 0x82, 0x5a,
+	0xa1, 0xf1, 0xc8, 0x3e, 0x83, 0xa5, 0x49, 0x3a, 0x91, 0xfe, 0x1b, 0x01, 0x26, 0x1d, 0x47, 0x07,
+	0xb2, 0xb4, 0x02, 0x3b, 0xcb, 0x90, 0x09, 0x4c, 0xd3, 0x24, 0x98, 0x44, 0x6c, 0x27, 0x87, 0x65,
+	0xbf, 0x07, 0x6b, 0xb2, 0x26, 0x23, 0x5d, 0xe7, 0x99, 0x2b, 0xbb, 0xc5, 0x2d, 0x39, 0x2b, 0x83,
+	0xc2, 0x58, 0x36, 0x7f, 0x80, 0xb5, 0x99, 0xd1, 0x02, 0xff, 0xca, 0xa0, 0x1f, 0x6e, 0x4c, 0xad,
+	0x67, 0x46, 0x98, 0xc7, 0xa4, 0x6f, 0xf7, 0x69, 0xfc, 0x31, 0x1e, 0xbd, 0xac, 0x1f, 0xef, 0x53,
+	0x41, 0xcd, 0x7f, 0x2e, 0x41, 0xc3, 0x94, 0x17, 0x45, 0x45, 0xbc, 0x02, 0x56, 0xa8, 0x67, 0xf4,
+	0xcb, 0x40, 0x69, 0xbb, 0x54, 0xd4, 0x87, 0x7e, 0x06, 0xcd, 0xd5, 0x2d, 0xfa, 0x54, 0xda, 0x93,
+	0x6a, 0xa8, 0x98, 0xa2, 0x97, 0x4d, 0xf4, 0xce, 0x1b, 0x3d, 0xc9, 0x48, 0x6b, 0x9f, 0x3c, 0xa2,
+	0x77, 0x9f, 0xfe, 0x37, 0xf2, 0xe2, 0x7f, 0x07, 0x00, 0x60, 0xf7, 0x7f, 0xd4, 0x73, 0x22, 0x00,
+	0x00,
 }
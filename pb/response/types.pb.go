@@ -481,6 +481,7 @@ type Row struct {
 	TabularNameGroups    []string     `protobuf:"bytes,12,rep,name=tabular_name_groups,json=tabular-name-groups,proto3" json:"tabular_name_groups,omitempty"`
 	MetricInfo           []*RawMetric `protobuf:"bytes,13,rep,name=metric_info,json=-,proto3" json:"metric_info,omitempty"`
 	Graphs               []*Graph     `protobuf:"bytes,14,rep,name=graphs,proto3" json:"graphs,omitempty"`
+	UserProperty         []string     `protobuf:"bytes,15,rep,name=user_property,json=user-property,proto3" json:"user_property,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -609,6 +610,13 @@ func (m *Row) GetGraphs() []*Graph {
 	return nil
 }
 
+func (m *Row) GetUserProperty() []string {
+	if m != nil {
+		return m.UserProperty
+	}
+	return nil
+}
+
 type TestMetadata struct {
 	BugComponent         int32    `protobuf:"varint,1,opt,name=bug_component,json=bug-component,proto3" json:"bug_component,omitempty"`
 	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -1104,104 +1112,105 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 1582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0xef, 0x6e, 0x1b, 0xc7,
-	0x11, 0x07, 0x45, 0x91, 0xe2, 0x0d, 0x45, 0x46, 0x5a, 0xa9, 0xf6, 0x86, 0x51, 0x14, 0x86, 0x91,
-	0x6d, 0x26, 0xc5, 0x31, 0x81, 0x02, 0x34, 0x41, 0xd2, 0x22, 0xb0, 0xdd, 0xd6, 0x30, 0x50, 0x1b,
-	0xc5, 0x59, 0xe8, 0x07, 0x7f, 0x39, 0x2c, 0xef, 0x56, 0xe4, 0x41, 0xf7, 0xaf, 0xb7, 0x7b, 0x56,
-	0xd5, 0xcf, 0x7d, 0x86, 0xbe, 0x48, 0xdf, 0xa4, 0x4f, 0x54, 0xcc, 0xcc, 0x1e, 0xc9, 0xab, 0x59,
-	0xf4, 0x13, 0x6f, 0x7e, 0xb3, 0x33, 0x3b, 0x33, 0xfb, 0xdb, 0x99, 0x25, 0x0c, 0xed, 0x43, 0xa9,
-	0xcd, 0xa2, 0xac, 0x0a, 0x5b, 0x4c, 0x1e, 0x95, 0xcb, 0x6f, 0xa3, 0x22, 0xbf, 0x4d, 0x56, 0xee,
-	0xc7, 0xe1, 0xb2, 0x5c, 0x7e, 0x6b, 0xea, 0x2c, 0x53, 0xd5, 0x43, 0xf3, 0xcb, 0x9a, 0xd9, 0xdf,
-	0x01, 0xfe, 0xbc, 0x56, 0x46, 0xdf, 0x24, 0x99, 0xae, 0xc4, 0x39, 0xf4, 0x8c, 0x55, 0x95, 0x95,
-	0x9d, 0x69, 0x67, 0xde, 0x0b, 0x58, 0x10, 0x02, 0x0e, 0x53, 0x65, 0xac, 0x3c, 0x20, 0x90, 0xbe,
-	0xc5, 0x23, 0xe8, 0x97, 0x68, 0x67, 0x64, 0x77, 0xda, 0x9d, 0x7b, 0x81, 0x93, 0xd0, 0x43, 0xac,
-	0x53, 0xab, 0xe4, 0xe1, 0xb4, 0x3b, 0xef, 0x04, 0x2c, 0x20, 0x6a, 0x0b, 0xab, 0x52, 0xd9, 0x9b,
-	0x76, 0x10, 0x25, 0x61, 0xf6, 0xaf, 0x2e, 0x78, 0x37, 0xda, 0xd8, 0xe7, 0xa9, 0xae, 0xac, 0xb8,
-	0x82, 0xd1, 0xad, 0x4a, 0xd2, 0x70, 0x59, 0x27, 0x69, 0x1c, 0x26, 0x31, 0xc5, 0xe0, 0x05, 0x04,
-	0xfa, 0x04, 0xfa, 0x49, 0x2c, 0x2e, 0x01, 0x68, 0x55, 0x54, 0xd4, 0x39, 0x47, 0xd4, 0x0d, 0x08,
-	0xf1, 0x09, 0x11, 0x17, 0xe0, 0x91, 0xde, 0x26, 0x99, 0x96, 0x5d, 0x0a, 0x98, 0x00, 0x1f, 0x01,
-	0xcc, 0xc4, 0xea, 0xbf, 0x59, 0x79, 0x48, 0xae, 0xe9, 0x5b, 0x48, 0x38, 0xca, 0xb4, 0x31, 0x6a,
-	0xa5, 0x29, 0x3a, 0x2f, 0x68, 0x44, 0xf4, 0x95, 0x26, 0xf9, 0x5d, 0x48, 0x26, 0x7d, 0xd2, 0x11,
-	0xe0, 0x93, 0x1d, 0x56, 0x25, 0xc9, 0xef, 0xe4, 0x11, 0xfb, 0xc2, 0x6f, 0x31, 0x81, 0x41, 0x5d,
-	0xa5, 0x6c, 0x30, 0x20, 0x1c, 0x65, 0xbf, 0xd9, 0xc7, 0x6a, 0x63, 0x31, 0x33, 0x8f, 0xf7, 0x41,
-	0x11, 0x73, 0xba, 0x82, 0x51, 0xa9, 0x8c, 0xd9, 0x66, 0x0e, 0x9c, 0x39, 0x82, 0xad, 0xcc, 0x69,
-	0x15, 0x67, 0x3e, 0xe4, 0xcc, 0x69, 0xc9, 0x26, 0x73, 0xd2, 0x53, 0xe6, 0xc7, 0x9c, 0x39, 0xa9,
-	0x29, 0xf3, 0x6f, 0xe0, 0x24, 0x2a, 0x62, 0x1d, 0x1a, 0xad, 0xaa, 0x68, 0x1d, 0x96, 0xca, 0xae,
-	0xe5, 0x88, 0xb6, 0x21, 0xdc, 0x67, 0xdc, 0x47, 0x1c, 0x3d, 0x51, 0xa4, 0xb9, 0xca, 0xb4, 0x1c,
-	0x73, 0xde, 0x14, 0x2b, 0x02, 0xb3, 0x1f, 0xc0, 0x0b, 0x52, 0xfd, 0xce, 0x2a, 0x5b, 0xd3, 0x71,
-	0x73, 0x3c, 0x8e, 0x30, 0x1c, 0xca, 0x39, 0xf4, 0x3e, 0xa8, 0xb4, 0xd6, 0x8e, 0x31, 0x2c, 0xcc,
-	0x5e, 0x83, 0x17, 0xa8, 0xfb, 0x37, 0xda, 0x56, 0x49, 0x24, 0xc6, 0x70, 0xb0, 0x39, 0xe2, 0x83,
-	0x24, 0x46, 0x3e, 0xa5, 0xea, 0xa1, 0xa8, 0xf1, 0x4c, 0xbb, 0xf3, 0x5e, 0xe0, 0xa4, 0xad, 0xab,
-	0x2e, 0xf3, 0x89, 0x5d, 0xfd, 0xb3, 0x03, 0xbd, 0x57, 0x95, 0x2a, 0xd7, 0x68, 0x97, 0x91, 0x47,
-	0xe7, 0xcb, 0x49, 0x68, 0x87, 0xd1, 0x1a, 0x72, 0xe7, 0x05, 0x2c, 0xf0, 0x2e, 0x4b, 0x9d, 0x6e,
-	0x58, 0xcb, 0x92, 0x78, 0x02, 0x7d, 0x72, 0x6c, 0x88, 0xb6, 0xc3, 0xeb, 0xd1, 0x82, 0xbc, 0x2f,
-	0xfe, 0x42, 0x60, 0xe0, 0x94, 0x93, 0x29, 0xf4, 0x19, 0x41, 0x47, 0xce, 0xa0, 0x43, 0x71, 0x39,
-	0x69, 0xf6, 0xef, 0x2e, 0x74, 0x83, 0xe2, 0x1e, 0xc9, 0x41, 0xd5, 0xe3, 0xa0, 0xe8, 0x1b, 0x8f,
-	0xb9, 0xa8, 0x92, 0x55, 0x92, 0xab, 0x94, 0x4b, 0x7b, 0xc0, 0xc7, 0xdc, 0x80, 0x54, 0x5e, 0x31,
-	0x85, 0x9e, 0xc2, 0xfb, 0x40, 0xe4, 0x1d, 0x5e, 0xc3, 0x62, 0x73, 0x43, 0x02, 0x56, 0x88, 0x2f,
-	0x60, 0x88, 0x64, 0xd3, 0x71, 0xb8, 0xac, 0x57, 0x1c, 0xb1, 0x17, 0x00, 0x43, 0x2f, 0xea, 0x95,
-	0x41, 0x16, 0x3a, 0x0a, 0x1b, 0xd9, 0x23, 0xed, 0x46, 0x46, 0x63, 0xb3, 0x2e, 0x2a, 0x4b, 0x1c,
-	0x35, 0xb2, 0xcf, 0xc6, 0x04, 0xdd, 0x20, 0x22, 0x3e, 0x85, 0x81, 0xa3, 0xa9, 0x91, 0x47, 0xa4,
-	0x25, 0x9e, 0xbe, 0x8e, 0x8d, 0xb8, 0x82, 0x71, 0x63, 0xeb, 0x16, 0x0c, 0xa6, 0xdd, 0x79, 0x37,
-	0x38, 0x76, 0xe6, 0xbc, 0xea, 0x29, 0x0c, 0x0c, 0x91, 0x43, 0x1b, 0xe9, 0x51, 0x35, 0x61, 0xb1,
-	0x21, 0x4c, 0xb0, 0xd1, 0x61, 0x09, 0xad, 0xaa, 0x56, 0xda, 0x3a, 0xba, 0x3b, 0x49, 0x4c, 0xa0,
-	0x87, 0xfe, 0x8d, 0x1c, 0x92, 0xf1, 0xe1, 0x22, 0x28, 0xee, 0x03, 0x86, 0xc4, 0x77, 0x70, 0x66,
-	0xd5, 0xb2, 0x4e, 0x55, 0x45, 0x15, 0x0c, 0x57, 0x55, 0x51, 0x97, 0x46, 0x1e, 0x53, 0x9c, 0x8d,
-	0x8a, 0xea, 0xe8, 0xb3, 0x4a, 0xcc, 0x60, 0xc8, 0x8c, 0x08, 0x93, 0xfc, 0xb6, 0x90, 0xa3, 0x26,
-	0xa0, 0x86, 0x88, 0x41, 0xc7, 0x17, 0x97, 0xd0, 0x5f, 0xe1, 0x71, 0x1b, 0x39, 0x26, 0x75, 0x9f,
-	0x4f, 0x3f, 0x70, 0xe8, 0xec, 0x3d, 0x1c, 0x63, 0x72, 0x6f, 0xb4, 0x55, 0xb1, 0xb2, 0x0a, 0x0f,
-	0x72, 0x59, 0xaf, 0xc2, 0xa8, 0xc8, 0xca, 0x22, 0xd7, 0x1b, 0xf2, 0x23, 0xe8, 0x6f, 0x40, 0x64,
-	0x60, 0x71, 0x9f, 0xeb, 0xca, 0x1d, 0x33, 0x0b, 0xc8, 0xfb, 0x28, 0x72, 0xec, 0x3b, 0x88, 0xa2,
-	0xd9, 0x3f, 0x4e, 0x61, 0x10, 0x68, 0x53, 0x16, 0xb9, 0xd1, 0x62, 0x0e, 0x9f, 0x50, 0x69, 0x29,
-	0xf6, 0x70, 0x87, 0x40, 0x04, 0x73, 0x4a, 0xcc, 0x92, 0x09, 0x0c, 0xff, 0x5a, 0xeb, 0xea, 0x21,
-	0x2c, 0x55, 0xa5, 0xb2, 0x66, 0x0b, 0x82, 0xb0, 0xb0, 0x5c, 0x64, 0xa2, 0x90, 0x17, 0x38, 0x49,
-	0xf8, 0x30, 0xa4, 0x26, 0x4d, 0x1d, 0xa2, 0xa2, 0x1e, 0x38, 0xbc, 0x1e, 0x2e, 0xb6, 0xed, 0x3f,
-	0x60, 0x3d, 0x35, 0x8c, 0x0a, 0xdd, 0x44, 0x2a, 0x5a, 0xeb, 0x98, 0xda, 0xe2, 0x20, 0x70, 0x12,
-	0xf6, 0x31, 0x37, 0x42, 0x5c, 0x4f, 0x6c, 0x44, 0xf1, 0x0c, 0x0e, 0x89, 0x91, 0x47, 0x54, 0xc5,
-	0xb3, 0x45, 0x93, 0xd7, 0x02, 0x59, 0xf9, 0x87, 0xdc, 0x56, 0x0f, 0x01, 0x2d, 0xc0, 0x06, 0xd3,
-	0xf4, 0x3a, 0xe6, 0x90, 0x17, 0x78, 0x4d, 0x9f, 0x33, 0xe2, 0x73, 0x80, 0xa8, 0x48, 0xeb, 0x2c,
-	0x27, 0xb5, 0xc7, 0x6a, 0x46, 0x90, 0x5f, 0xbf, 0xc0, 0x38, 0xaa, 0x8d, 0x2d, 0xb2, 0x90, 0x31,
-	0x23, 0x81, 0xf6, 0x7b, 0xbc, 0xdd, 0xef, 0x25, 0xe9, 0x5f, 0xb2, 0x3a, 0x70, 0xcb, 0x7d, 0xb7,
-	0x1c, 0x49, 0xe4, 0xfc, 0xaf, 0xb5, 0x8a, 0x35, 0x53, 0x89, 0xe9, 0xe6, 0x05, 0x4e, 0xe5, 0xb3,
-	0xca, 0xdf, 0xb4, 0x8d, 0x16, 0xd3, 0x9c, 0xc4, 0xa3, 0x03, 0x59, 0x64, 0x88, 0x58, 0x34, 0x3a,
-	0x48, 0xdc, 0x92, 0x78, 0xfc, 0x31, 0x89, 0x1f, 0xc3, 0x51, 0x55, 0xdc, 0x53, 0x72, 0x9f, 0xb0,
-	0xbb, 0xaa, 0xb8, 0xc7, 0xcc, 0x2e, 0x01, 0xb0, 0xf4, 0xc6, 0xaa, 0xac, 0x34, 0xf2, 0x84, 0xee,
-	0xd6, 0x0e, 0x22, 0x7e, 0x84, 0xa1, 0xbb, 0x79, 0x61, 0xa6, 0x4a, 0x79, 0x4a, 0xae, 0xe5, 0x36,
-	0x6d, 0xbe, 0x81, 0x6f, 0x54, 0xc9, 0xb5, 0xf6, 0x6c, 0x23, 0x8b, 0xe7, 0x30, 0x22, 0xcb, 0xcc,
-	0x51, 0x58, 0x0a, 0xb2, 0xfd, 0xac, 0x6d, 0xdb, 0x10, 0x9c, 0xcd, 0xc9, 0xc2, 0x6f, 0x2c, 0xc4,
-	0x35, 0x9c, 0x1b, 0xab, 0x52, 0xcd, 0x97, 0xdf, 0xae, 0x2b, 0x6d, 0xd6, 0x45, 0x1a, 0xcb, 0x33,
-	0xe2, 0x3e, 0xeb, 0x7c, 0x32, 0xd9, 0xe8, 0x90, 0xcf, 0x79, 0x9d, 0x85, 0x5b, 0x3b, 0x23, 0xcf,
-	0x69, 0x6e, 0x21, 0xec, 0x6f, 0x4d, 0xa8, 0xc2, 0xd4, 0xdc, 0x8c, 0xfc, 0x15, 0x73, 0x96, 0x25,
-	0xf1, 0x13, 0x48, 0x15, 0xc7, 0xe1, 0xee, 0xa5, 0x37, 0x61, 0x51, 0xda, 0xa4, 0xc8, 0xe5, 0x23,
-	0xa2, 0x25, 0xea, 0xfd, 0xdd, 0x9b, 0x6f, 0x7c, 0xd6, 0x8b, 0x05, 0x08, 0xb3, 0x2e, 0xee, 0xdb,
-	0xc6, 0xf2, 0x31, 0x59, 0x91, 0xa6, 0x6d, 0x26, 0x5e, 0xc0, 0x45, 0x7b, 0x9f, 0x16, 0x4b, 0x8c,
-	0x94, 0x74, 0x58, 0x17, 0xed, 0xbd, 0x5a, 0x74, 0x31, 0x62, 0x0a, 0xc3, 0x58, 0x9b, 0xa8, 0x4a,
-	0x38, 0xc4, 0x4f, 0x29, 0x99, 0x5d, 0xe8, 0xe3, 0xe6, 0x31, 0xd9, 0xd7, 0x3c, 0xf6, 0x8d, 0xeb,
-	0xcf, 0xfe, 0xc7, 0xb8, 0xfe, 0x1d, 0x88, 0xa2, 0xd4, 0xb9, 0x3b, 0x18, 0x9d, 0x95, 0xa9, 0xb2,
-	0x5a, 0x5e, 0xd0, 0xf5, 0x1e, 0x2d, 0xfe, 0x94, 0xe4, 0x77, 0x37, 0x0e, 0x0c, 0x68, 0xa1, 0x3b,
-	0x25, 0x87, 0x89, 0x9f, 0xe1, 0xf4, 0x36, 0x49, 0x35, 0x0e, 0x93, 0xad, 0xf5, 0xe7, 0xfb, 0xac,
-	0x69, 0x9d, 0x8f, 0x81, 0x6e, 0x8c, 0x7f, 0x81, 0x33, 0x65, 0xad, 0x8a, 0xd6, 0x6d, 0xf3, 0xcb,
-	0x7d, 0xe6, 0x6e, 0x65, 0xdb, 0xc1, 0x73, 0x38, 0xaf, 0xb4, 0xa9, 0x53, 0x6b, 0x42, 0x7e, 0x39,
-	0x39, 0x0f, 0x5f, 0xec, 0xf3, 0xd0, 0x2c, 0xf5, 0xf9, 0x51, 0xe5, 0x5c, 0xbc, 0x06, 0xb9, 0x5b,
-	0xab, 0x96, 0x9b, 0xe9, 0x3e, 0x37, 0x72, 0xb7, 0x84, 0x2d, 0x57, 0x3f, 0xc3, 0x29, 0x95, 0xb2,
-	0x95, 0xcc, 0x97, 0x7b, 0x6b, 0x41, 0x95, 0x6c, 0xa5, 0xf2, 0x1d, 0x9c, 0xa9, 0x65, 0x51, 0xdb,
-	0x30, 0x56, 0x66, 0xbd, 0x2c, 0x54, 0x15, 0x63, 0x2c, 0x72, 0x46, 0xc7, 0xc6, 0x2a, 0x7f, 0xa3,
-	0xc2, 0x7d, 0xc5, 0x0c, 0x8e, 0x9b, 0xe4, 0xe9, 0xc9, 0xf8, 0x15, 0x2d, 0x6d, 0x30, 0x7e, 0x36,
-	0xce, 0xe0, 0x18, 0xbd, 0xd3, 0x54, 0xd0, 0x3a, 0x97, 0x57, 0xbc, 0x86, 0x31, 0x9f, 0x30, 0xf1,
-	0x14, 0xc6, 0xb6, 0x4a, 0xd4, 0x4a, 0x87, 0x3a, 0x57, 0xcb, 0x54, 0xc7, 0xf2, 0x09, 0xb1, 0xdc,
-	0xa1, 0xbe, 0x43, 0xc5, 0xf7, 0x30, 0xca, 0x0b, 0x9b, 0xdc, 0x26, 0x91, 0x42, 0x2e, 0x1a, 0xf9,
-	0xd4, 0xbd, 0x76, 0xde, 0xee, 0xa0, 0x41, 0x7b, 0x8d, 0xf8, 0x12, 0x60, 0x3b, 0x94, 0xe4, 0xb3,
-	0x9d, 0x57, 0xc9, 0x2b, 0x44, 0x70, 0x80, 0x3e, 0x83, 0xd1, 0x36, 0x67, 0xab, 0x96, 0x72, 0xee,
-	0x4a, 0xf6, 0xfb, 0x06, 0xbd, 0x51, 0x4b, 0x5c, 0xf8, 0x47, 0x18, 0x17, 0x1f, 0x74, 0xa5, 0xd2,
-	0x34, 0x74, 0x23, 0xea, 0xeb, 0x69, 0x67, 0x3e, 0xbe, 0xbe, 0x6c, 0xad, 0x7c, 0xe7, 0xfe, 0xa1,
-	0xe0, 0x27, 0xbf, 0x1a, 0x1a, 0x2b, 0xdf, 0x8d, 0xb2, 0x27, 0x30, 0xde, 0x6e, 0x48, 0x73, 0xf2,
-	0x1b, 0x7e, 0x4b, 0x6d, 0xd0, 0xb7, 0x38, 0x25, 0xbf, 0x86, 0x93, 0xba, 0x8c, 0x95, 0xe5, 0x91,
-	0x47, 0x5d, 0x54, 0xfe, 0x9a, 0x1b, 0x10, 0xe3, 0x37, 0x0d, 0x3c, 0xf9, 0x01, 0xbc, 0xcd, 0x94,
-	0x12, 0x27, 0xd0, 0xbd, 0xd3, 0x0f, 0x6e, 0xf6, 0xe2, 0x67, 0xfb, 0x45, 0xeb, 0xb9, 0x67, 0xe8,
-	0x4f, 0x07, 0x3f, 0x76, 0x26, 0xbf, 0x81, 0x51, 0x6b, 0xdc, 0x60, 0x6c, 0xff, 0x35, 0x9f, 0x3a,
-	0xd4, 0x38, 0x46, 0xd1, 0xee, 0xb2, 0xc9, 0x6f, 0x61, 0xdc, 0xee, 0xd7, 0xbb, 0xbb, 0x76, 0xff,
-	0xdf, 0xae, 0x6f, 0xe1, 0xf4, 0xa3, 0x8e, 0xbd, 0x27, 0xec, 0xaf, 0x76, 0x1d, 0xe0, 0x81, 0xec,
-	0x1a, 0xed, 0xf8, 0x7b, 0x01, 0xef, 0x07, 0x95, 0x1b, 0x05, 0xcb, 0x3e, 0xfd, 0x33, 0xfc, 0xfe,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x0b, 0x45, 0x67, 0x5a, 0x0e, 0x00, 0x00,
+	// 1596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0x5f, 0x6f, 0xe3, 0xc6,
+	0x11, 0x87, 0x2c, 0x4b, 0x36, 0x47, 0x96, 0x72, 0x5e, 0xbb, 0x77, 0x1b, 0xe5, 0xe2, 0x28, 0xca,
+	0xfd, 0x71, 0x52, 0x50, 0x09, 0x1c, 0xa0, 0x09, 0x92, 0x16, 0xc1, 0x5d, 0xda, 0x06, 0x07, 0xf4,
+	0x0e, 0xc5, 0xc6, 0xe8, 0x43, 0x5e, 0x88, 0x15, 0xb9, 0x96, 0x88, 0xa3, 0x48, 0x76, 0x77, 0x79,
+	0xae, 0xfb, 0xdc, 0xcf, 0xd0, 0x2f, 0xd2, 0x2f, 0xd7, 0xc7, 0x62, 0x66, 0x96, 0x92, 0xd8, 0x73,
+	0xd1, 0x27, 0x6b, 0x7e, 0xf3, 0x67, 0x67, 0x66, 0x7f, 0x9c, 0x59, 0xc3, 0xc8, 0xdf, 0xd5, 0xc6,
+	0x2d, 0x6a, 0x5b, 0xf9, 0x6a, 0xfa, 0xb0, 0x5e, 0x7e, 0x99, 0x56, 0xe5, 0x4d, 0xbe, 0x0a, 0x7f,
+	0x02, 0x2e, 0xeb, 0xe5, 0x97, 0xae, 0xd9, 0x6c, 0xb4, 0xbd, 0x6b, 0xff, 0xb2, 0x66, 0xfe, 0x77,
+	0x80, 0x3f, 0xaf, 0xb5, 0x33, 0xd7, 0xf9, 0xc6, 0x58, 0x71, 0x0e, 0x03, 0xe7, 0xb5, 0xf5, 0xb2,
+	0x37, 0xeb, 0x5d, 0x0e, 0x14, 0x0b, 0x42, 0xc0, 0x61, 0xa1, 0x9d, 0x97, 0x07, 0x04, 0xd2, 0x6f,
+	0xf1, 0x10, 0x86, 0x35, 0xfa, 0x39, 0xd9, 0x9f, 0xf5, 0x2f, 0x23, 0x15, 0x24, 0x8c, 0x90, 0x99,
+	0xc2, 0x6b, 0x79, 0x38, 0xeb, 0x5f, 0xf6, 0x14, 0x0b, 0x88, 0xfa, 0xca, 0xeb, 0x42, 0x0e, 0x66,
+	0x3d, 0x44, 0x49, 0x98, 0xff, 0xab, 0x0f, 0xd1, 0xb5, 0x71, 0xfe, 0x45, 0x61, 0xac, 0x17, 0x4f,
+	0x60, 0x7c, 0xa3, 0xf3, 0x22, 0x59, 0x36, 0x79, 0x91, 0x25, 0x79, 0x46, 0x39, 0x44, 0x8a, 0xc0,
+	0x98, 0xc0, 0x38, 0xcf, 0xc4, 0x05, 0x00, 0x59, 0xa5, 0x55, 0x53, 0x72, 0x46, 0x7d, 0x45, 0x48,
+	0x4c, 0x88, 0x78, 0x0c, 0x11, 0xe9, 0x7d, 0xbe, 0x31, 0xb2, 0x4f, 0x09, 0x13, 0x10, 0x23, 0x80,
+	0x95, 0x78, 0xf3, 0x37, 0x2f, 0x0f, 0x29, 0x34, 0xfd, 0x16, 0x12, 0x8e, 0x36, 0xc6, 0x39, 0xbd,
+	0x32, 0x94, 0x5d, 0xa4, 0x5a, 0x11, 0x63, 0x15, 0x79, 0xf9, 0x36, 0x21, 0x97, 0x21, 0xe9, 0x08,
+	0x88, 0xc9, 0x0f, 0xbb, 0x92, 0x97, 0x6f, 0xe5, 0x11, 0xc7, 0xc2, 0xdf, 0x62, 0x0a, 0xc7, 0x8d,
+	0x2d, 0xd8, 0xe1, 0x98, 0x70, 0x94, 0xe3, 0xf6, 0x1c, 0x6f, 0x9c, 0xc7, 0xca, 0x22, 0x3e, 0x07,
+	0x45, 0xac, 0xe9, 0x09, 0x8c, 0x6b, 0xed, 0xdc, 0xae, 0x72, 0xe0, 0xca, 0x11, 0xec, 0x54, 0x4e,
+	0x56, 0x5c, 0xf9, 0x88, 0x2b, 0x27, 0x93, 0x6d, 0xe5, 0xa4, 0xa7, 0xca, 0x4f, 0xb8, 0x72, 0x52,
+	0x53, 0xe5, 0x5f, 0xc0, 0x83, 0xb4, 0xca, 0x4c, 0xe2, 0x8c, 0xb6, 0xe9, 0x3a, 0xa9, 0xb5, 0x5f,
+	0xcb, 0x31, 0x1d, 0x43, 0x78, 0xcc, 0x78, 0x8c, 0x38, 0x46, 0xa2, 0x4c, 0x4b, 0xbd, 0x31, 0x72,
+	0xc2, 0x75, 0x53, 0xae, 0x08, 0xcc, 0xbf, 0x81, 0x48, 0x15, 0xe6, 0x67, 0xaf, 0x7d, 0x43, 0xd7,
+	0xcd, 0xf9, 0x04, 0xc2, 0x70, 0x2a, 0xe7, 0x30, 0x78, 0xa7, 0x8b, 0xc6, 0x04, 0xc6, 0xb0, 0x30,
+	0x7f, 0x05, 0x91, 0xd2, 0xb7, 0xaf, 0x8d, 0xb7, 0x79, 0x2a, 0x26, 0x70, 0xb0, 0xbd, 0xe2, 0x83,
+	0x3c, 0x43, 0x3e, 0x15, 0xfa, 0xae, 0x6a, 0xf0, 0x4e, 0xfb, 0x97, 0x03, 0x15, 0xa4, 0x5d, 0xa8,
+	0x3e, 0xf3, 0x89, 0x43, 0xfd, 0xb3, 0x07, 0x83, 0x9f, 0xac, 0xae, 0xd7, 0xe8, 0xb7, 0xa1, 0x88,
+	0x21, 0x56, 0x90, 0xd0, 0x0f, 0xb3, 0x75, 0x14, 0x2e, 0x52, 0x2c, 0xf0, 0x29, 0x4b, 0x53, 0x6c,
+	0x59, 0xcb, 0x92, 0x78, 0x0a, 0x43, 0x0a, 0xec, 0x88, 0xb6, 0xa3, 0xab, 0xf1, 0x82, 0xa2, 0x2f,
+	0xfe, 0x42, 0xa0, 0x0a, 0xca, 0xe9, 0x0c, 0x86, 0x8c, 0x60, 0xa0, 0xe0, 0xd0, 0xa3, 0xbc, 0x82,
+	0x34, 0xff, 0x77, 0x1f, 0xfa, 0xaa, 0xba, 0x45, 0x72, 0x50, 0xf7, 0x38, 0x29, 0xfa, 0x8d, 0xd7,
+	0x5c, 0xd9, 0x7c, 0x95, 0x97, 0xba, 0xe0, 0xd6, 0x1e, 0xf0, 0x35, 0xb7, 0x20, 0xb5, 0x57, 0xcc,
+	0x60, 0xa0, 0xf1, 0x7b, 0x20, 0xf2, 0x8e, 0xae, 0x60, 0xb1, 0xfd, 0x42, 0x14, 0x2b, 0xc4, 0x27,
+	0x30, 0x42, 0xb2, 0x99, 0x2c, 0x59, 0x36, 0x2b, 0xce, 0x38, 0x52, 0xc0, 0xd0, 0xcb, 0x66, 0xe5,
+	0x90, 0x85, 0x81, 0xc2, 0x4e, 0x0e, 0x48, 0xbb, 0x95, 0xd1, 0xd9, 0xad, 0x2b, 0xeb, 0x89, 0xa3,
+	0x4e, 0x0e, 0xd9, 0x99, 0xa0, 0x6b, 0x44, 0xc4, 0x87, 0x70, 0x1c, 0x68, 0xea, 0xe4, 0xd1, 0xac,
+	0xdf, 0xf2, 0xf4, 0x55, 0xe6, 0xc4, 0x13, 0x98, 0xb4, 0xbe, 0xc1, 0xe0, 0x78, 0xd6, 0xbf, 0xec,
+	0xab, 0x93, 0xe0, 0xce, 0x56, 0xcf, 0xe0, 0xd8, 0x11, 0x39, 0x8c, 0x93, 0x11, 0x75, 0x13, 0x16,
+	0x5b, 0xc2, 0xa8, 0xad, 0x0e, 0x5b, 0xe8, 0xb5, 0x5d, 0x19, 0x1f, 0xe8, 0x1e, 0x24, 0x31, 0x85,
+	0x01, 0xc6, 0x77, 0x72, 0x44, 0xce, 0x87, 0x0b, 0x55, 0xdd, 0x2a, 0x86, 0xc4, 0x57, 0x70, 0xe6,
+	0xf5, 0xb2, 0x29, 0xb4, 0xa5, 0x0e, 0x26, 0x2b, 0x5b, 0x35, 0xb5, 0x93, 0x27, 0x94, 0x67, 0xab,
+	0xa2, 0x3e, 0xc6, 0xac, 0x12, 0x73, 0x18, 0x31, 0x23, 0x92, 0xbc, 0xbc, 0xa9, 0xe4, 0xb8, 0x4d,
+	0xa8, 0x25, 0xa2, 0xea, 0xc5, 0xe2, 0x02, 0x86, 0x2b, 0xbc, 0x6e, 0x27, 0x27, 0xa4, 0x1e, 0xf2,
+	0xed, 0xab, 0x80, 0xe2, 0xc5, 0x35, 0xce, 0xd8, 0xa4, 0xb6, 0x55, 0x6d, 0xac, 0xbf, 0x93, 0x1f,
+	0xd0, 0x79, 0x04, 0xc6, 0x2d, 0x38, 0xff, 0x05, 0x4e, 0xb0, 0x05, 0xaf, 0x8d, 0xd7, 0x99, 0xf6,
+	0x1a, 0xbd, 0x96, 0xcd, 0x2a, 0x49, 0xab, 0x4d, 0x5d, 0x95, 0x66, 0xfb, 0x89, 0x20, 0x18, 0x6f,
+	0x41, 0xe4, 0x69, 0x75, 0x5b, 0x1a, 0x1b, 0xc8, 0xc0, 0x02, 0x7e, 0x1d, 0x69, 0x1a, 0x38, 0x7a,
+	0x90, 0xa6, 0xf3, 0x7f, 0x9c, 0xc2, 0xb1, 0x32, 0xae, 0xae, 0x4a, 0x67, 0xc4, 0x25, 0x7c, 0x40,
+	0x17, 0x40, 0x15, 0x26, 0x7b, 0x34, 0x23, 0x98, 0x0b, 0x67, 0x2e, 0x4d, 0x61, 0xf4, 0xd7, 0xc6,
+	0xd8, 0xbb, 0xa4, 0xd6, 0x56, 0x6f, 0xda, 0x23, 0x08, 0xc2, 0xf6, 0xf3, 0x55, 0x10, 0xd1, 0x22,
+	0x15, 0x24, 0x11, 0xc3, 0x88, 0x46, 0x39, 0xcd, 0x11, 0x4b, 0x93, 0x72, 0x74, 0x35, 0x5a, 0xec,
+	0x96, 0x84, 0x62, 0x3d, 0x8d, 0x15, 0x8b, 0x61, 0x52, 0x9d, 0xae, 0x4d, 0x46, 0xc3, 0xf3, 0x58,
+	0x05, 0x09, 0xa7, 0x5d, 0x58, 0x34, 0x61, 0x72, 0xb6, 0xa2, 0x78, 0x0e, 0x87, 0xc4, 0xdb, 0x23,
+	0xea, 0xf5, 0xd9, 0xa2, 0xad, 0x6b, 0x81, 0xdc, 0xfd, 0x43, 0xe9, 0xed, 0x9d, 0x22, 0x03, 0x1c,
+	0x43, 0xed, 0x44, 0x64, 0xa6, 0x45, 0x2a, 0x6a, 0xa7, 0xa1, 0x13, 0x1f, 0x03, 0xa4, 0x55, 0xd1,
+	0x6c, 0x4a, 0x52, 0x47, 0xac, 0x66, 0x04, 0x59, 0xf8, 0x03, 0x4c, 0xd2, 0xc6, 0xf9, 0x6a, 0x93,
+	0x30, 0xe6, 0x24, 0xd0, 0x79, 0x8f, 0x76, 0xe7, 0xfd, 0x48, 0xfa, 0x1f, 0x59, 0xad, 0x82, 0x79,
+	0x1c, 0xcc, 0x91, 0x6a, 0x21, 0xfe, 0xda, 0xe8, 0xcc, 0x30, 0xe1, 0x98, 0x94, 0x91, 0x0a, 0xaa,
+	0x98, 0x55, 0xf1, 0x76, 0xb8, 0x74, 0xf8, 0x18, 0x24, 0x5e, 0x30, 0xc8, 0x35, 0x47, 0xf4, 0x8b,
+	0x54, 0x2b, 0xee, 0xa8, 0x3e, 0x79, 0x9f, 0xea, 0x8f, 0xe0, 0xc8, 0x56, 0xb7, 0x54, 0x1c, 0xd3,
+	0x6d, 0x68, 0xab, 0x5b, 0xac, 0xec, 0x02, 0x00, 0x5b, 0xef, 0xbc, 0xde, 0xd4, 0x4e, 0x3e, 0xa0,
+	0x2f, 0x70, 0x0f, 0x11, 0xdf, 0xc2, 0x28, 0x7c, 0x9f, 0xc9, 0x46, 0xd7, 0xf2, 0x94, 0x42, 0xcb,
+	0x5d, 0xd9, 0xfc, 0x9d, 0xbe, 0xd6, 0x35, 0xf7, 0x3a, 0xf2, 0xad, 0x2c, 0x5e, 0xc0, 0x98, 0x3c,
+	0x37, 0x81, 0xc2, 0x52, 0x90, 0xef, 0x47, 0x5d, 0xdf, 0x96, 0xe0, 0xec, 0x4e, 0x1e, 0x71, 0xeb,
+	0x21, 0xae, 0xe0, 0xdc, 0x79, 0x5d, 0x18, 0x1e, 0x11, 0x7e, 0x6d, 0x8d, 0x5b, 0x57, 0x45, 0x26,
+	0xcf, 0x88, 0xfb, 0xac, 0x8b, 0xc9, 0x65, 0xab, 0x43, 0x3e, 0x97, 0xcd, 0x26, 0xd9, 0xf9, 0x39,
+	0x79, 0x4e, 0xdb, 0x0d, 0xe1, 0x78, 0xe7, 0x42, 0x1d, 0xa6, 0x11, 0xe8, 0xe4, 0xaf, 0x98, 0xb3,
+	0x2c, 0x89, 0xef, 0x40, 0xea, 0x2c, 0x4b, 0xf6, 0x47, 0x83, 0x4b, 0xaa, 0xda, 0xe7, 0x55, 0x29,
+	0x1f, 0x12, 0x2d, 0x51, 0x1f, 0xef, 0xcf, 0x07, 0x17, 0xb3, 0x5e, 0x2c, 0x40, 0xb8, 0x75, 0x75,
+	0xdb, 0x75, 0x96, 0x8f, 0xc8, 0x8b, 0x34, 0x5d, 0x37, 0xf1, 0x12, 0x1e, 0x77, 0xcf, 0xe9, 0xb0,
+	0xc4, 0x49, 0x49, 0x97, 0xf5, 0xb8, 0x7b, 0x56, 0x87, 0x2e, 0x4e, 0xcc, 0x60, 0x94, 0x19, 0x97,
+	0xda, 0x9c, 0x53, 0xfc, 0x90, 0x8a, 0xd9, 0x87, 0xde, 0x1f, 0x1e, 0xd3, 0xfb, 0x86, 0xc7, 0x7d,
+	0x4b, 0xfd, 0xa3, 0xff, 0xb1, 0xd4, 0x7f, 0x07, 0xa2, 0xaa, 0x4d, 0x19, 0x2e, 0xc6, 0x6c, 0xea,
+	0x42, 0x7b, 0x23, 0x1f, 0xd3, 0xe7, 0x3d, 0x5e, 0xfc, 0x29, 0x2f, 0xdf, 0x5e, 0x07, 0x50, 0x91,
+	0x61, 0xb8, 0xa5, 0x80, 0x89, 0xef, 0xe1, 0xf4, 0x26, 0x2f, 0x0c, 0xae, 0x9c, 0x9d, 0xf7, 0xc7,
+	0xf7, 0x79, 0x93, 0x5d, 0x8c, 0x89, 0x6e, 0x9d, 0x7f, 0x80, 0x33, 0xed, 0xbd, 0x4e, 0xd7, 0x5d,
+	0xf7, 0x8b, 0xfb, 0xdc, 0x83, 0x65, 0x37, 0xc0, 0x0b, 0x38, 0xb7, 0xc6, 0x35, 0x85, 0x77, 0x09,
+	0xbf, 0xaf, 0x42, 0x84, 0x4f, 0xee, 0x8b, 0xd0, 0x9a, 0xc6, 0xfc, 0xf4, 0x0a, 0x21, 0x5e, 0x81,
+	0xdc, 0xef, 0x55, 0x27, 0xcc, 0xec, 0xbe, 0x30, 0x72, 0xbf, 0x85, 0x9d, 0x50, 0xdf, 0xc3, 0x29,
+	0xb5, 0xb2, 0x53, 0xcc, 0xa7, 0xf7, 0xf6, 0x82, 0x3a, 0xd9, 0x29, 0xe5, 0x2b, 0x38, 0xd3, 0xcb,
+	0xaa, 0xf1, 0x49, 0xa6, 0xdd, 0x7a, 0x59, 0x69, 0x9b, 0x61, 0x2e, 0x72, 0x4e, 0xd7, 0xc6, 0xaa,
+	0x78, 0xab, 0xc2, 0x73, 0xc5, 0x1c, 0x4e, 0xda, 0xe2, 0xe9, 0x61, 0xf9, 0x19, 0x99, 0xb6, 0x18,
+	0x3f, 0x2e, 0xe7, 0x70, 0x52, 0xe8, 0xb0, 0x15, 0x8c, 0x29, 0xe5, 0x13, 0xb6, 0x61, 0x2c, 0x26,
+	0x4c, 0x3c, 0x83, 0x89, 0xb7, 0xb9, 0x5e, 0x99, 0xc4, 0x94, 0x7a, 0x59, 0x98, 0x4c, 0x3e, 0x25,
+	0x96, 0x07, 0x34, 0x0e, 0xa8, 0xf8, 0x1a, 0xc6, 0x65, 0xe5, 0xf3, 0x9b, 0x3c, 0xd5, 0xc8, 0x45,
+	0x27, 0x9f, 0x85, 0x37, 0xd1, 0x9b, 0x3d, 0x54, 0x75, 0x6d, 0xc4, 0xa7, 0x00, 0xbb, 0xa5, 0x24,
+	0x9f, 0xef, 0xbd, 0x5d, 0x7e, 0x42, 0x04, 0xd7, 0xec, 0x73, 0x18, 0xef, 0x6a, 0xf6, 0x7a, 0x29,
+	0x2f, 0x43, 0xcb, 0x7e, 0xdf, 0xa2, 0xd7, 0x7a, 0x89, 0x86, 0x7f, 0x84, 0x49, 0xf5, 0xce, 0x58,
+	0x5d, 0x14, 0x49, 0x58, 0x51, 0x9f, 0xcf, 0x7a, 0x97, 0x93, 0xab, 0x8b, 0x8e, 0xe5, 0xcf, 0xe1,
+	0xff, 0x18, 0xfc, 0x49, 0x56, 0xaa, 0xf5, 0x8a, 0xd9, 0x4b, 0x3c, 0x85, 0xc9, 0xee, 0x40, 0xda,
+	0x93, 0x5f, 0xf0, 0x8b, 0x6b, 0x8b, 0xbe, 0xc1, 0x2d, 0xf9, 0x39, 0x3c, 0x68, 0xea, 0x4c, 0x7b,
+	0x93, 0x6c, 0xa7, 0xa8, 0xfc, 0x35, 0x0f, 0x20, 0xc6, 0xaf, 0x5b, 0x78, 0xfa, 0x0d, 0x44, 0xdb,
+	0x2d, 0x25, 0x1e, 0x40, 0xff, 0xad, 0xb9, 0x0b, 0xbb, 0x17, 0x7f, 0x76, 0xdf, 0xbd, 0x51, 0x78,
+	0xac, 0x7e, 0x77, 0xf0, 0x6d, 0x6f, 0xfa, 0x1b, 0x18, 0x77, 0xd6, 0x0d, 0xe6, 0xf6, 0x5f, 0xfb,
+	0xa9, 0xc7, 0x8f, 0x8a, 0x74, 0xdf, 0x6c, 0xfa, 0x5b, 0x98, 0x74, 0xe7, 0xf5, 0xfe, 0xa9, 0xfd,
+	0xff, 0x77, 0xea, 0x1b, 0x38, 0x7d, 0x6f, 0x62, 0xdf, 0x93, 0xf6, 0x67, 0xfb, 0x01, 0xf0, 0x42,
+	0xf6, 0x9d, 0xf6, 0xe2, 0xbd, 0x84, 0x5f, 0x8e, 0x6d, 0x58, 0x05, 0xcb, 0x21, 0xfd, 0xff, 0xf8,
+	0xf5, 0x7f, 0x06, 0x00, 0x5a, 0x5d, 0x8c, 0xcd, 0x80, 0x0e, 0x00, 0x00,
 }
@@ -506,10 +506,14 @@ type Row struct {
 	// IDs for bugs associated with results in this test case.
 	BugId []string `protobuf:"bytes,10,rep,name=bug_id,json=bugId,proto3" json:"bug_id,omitempty"`
 	// An alert for the failure if there's a recent failure for this test case.
-	AlertInfo            *AlertInfo `protobuf:"bytes,11,opt,name=alert_info,json=alertInfo,proto3" json:"alert_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	AlertInfo *AlertInfo `protobuf:"bytes,11,opt,name=alert_info,json=alertInfo,proto3" json:"alert_info,omitempty"`
+	// Value of the test group's user_property for each cell, such as the node
+	// or shard the test ran on. Present for any column with a non-empty status
+	// (not NO_RESULT) once any cell in the row has a value.
+	UserProperty         []string `protobuf:"bytes,12,rep,name=user_property,json=userProperty,proto3" json:"user_property,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Row) Reset()         { *m = Row{} }
@@ -607,6 +611,13 @@ func (m *Row) GetAlertInfo() *AlertInfo {
 	return nil
 }
 
+func (m *Row) GetUserProperty() []string {
+	if m != nil {
+		return m.UserProperty
+	}
+	return nil
+}
+
 // A single table of test results backing a dashboard tab.
 type Grid struct {
 	// A cycle of test results, not including the results. In the TestGrid client,
@@ -848,67 +859,68 @@ func init() {
 func init() { proto.RegisterFile("state.proto", fileDescriptor_a888679467bb7853) }
 
 var fileDescriptor_a888679467bb7853 = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x8e, 0xe3, 0x44,
-	0x10, 0x96, 0xf3, 0x67, 0xbb, 0x9c, 0xf9, 0xd9, 0xd6, 0xb2, 0x32, 0x83, 0x46, 0x9b, 0x35, 0x08,
-	0x02, 0x42, 0x1e, 0x29, 0x1c, 0xb8, 0x70, 0x59, 0x06, 0x58, 0x65, 0xc4, 0xac, 0x50, 0xef, 0xcc,
-	0xd9, 0x72, 0xec, 0x4e, 0xd6, 0x5a, 0xc7, 0x6d, 0x75, 0xb7, 0xc9, 0xcc, 0x99, 0x47, 0x40, 0x1c,
-	0x78, 0x28, 0xde, 0x09, 0x55, 0x75, 0x3b, 0xc9, 0x20, 0xa4, 0x3d, 0xc5, 0xdf, 0x57, 0xe5, 0xaa,
-	0x72, 0xfd, 0x7c, 0x81, 0x48, 0x9b, 0xdc, 0x88, 0xb4, 0x55, 0xd2, 0xc8, 0x8b, 0x97, 0x1b, 0x29,
-	0x37, 0xb5, 0xb8, 0x22, 0xb4, 0xea, 0xd6, 0x57, 0xa6, 0xda, 0x0a, 0x6d, 0xf2, 0x6d, 0xeb, 0x1c,
-	0x5e, 0xb4, 0xab, 0xab, 0x42, 0x36, 0xeb, 0x6a, 0xe3, 0x7e, 0x2c, 0x9f, 0xbc, 0x85, 0xc9, 0xad,
-	0x30, 0xaa, 0x2a, 0x18, 0x83, 0x51, 0x93, 0x6f, 0x45, 0xec, 0xcd, 0xbc, 0x79, 0xc8, 0xe9, 0x99,
-	0xc5, 0xe0, 0x57, 0x4d, 0x59, 0x15, 0x42, 0xc7, 0x83, 0xd9, 0x70, 0x3e, 0xe6, 0x3d, 0x64, 0x2f,
-	0x60, 0xf2, 0x7b, 0x5e, 0x77, 0x42, 0xc7, 0xc3, 0xd9, 0x70, 0xee, 0x71, 0x87, 0x92, 0x7b, 0x38,
-	0xbb, 0x6f, 0xcb, 0xdc, 0x88, 0xdf, 0xde, 0xe7, 0x5a, 0xfc, 0x94, 0x9b, 0x9c, 0x5d, 0x02, 0xb4,
-	0x08, 0xb2, 0xa3, 0xf0, 0x21, 0x31, 0x6f, 0x31, 0xc7, 0xe7, 0x70, 0x62, 0xcd, 0x5a, 0x14, 0xb2,
-	0x29, 0x31, 0x93, 0x37, 0xf7, 0xf8, 0x94, 0xc8, 0x77, 0x96, 0x4b, 0x6e, 0x00, 0x6c, 0xd8, 0x65,
-	0xb3, 0x96, 0xec, 0x07, 0x78, 0xd6, 0x11, 0xca, 0xec, 0x9b, 0x65, 0x6e, 0xf2, 0xd8, 0x9b, 0x0d,
-	0xe7, 0xd1, 0xe2, 0x3c, 0xfd, 0x4f, 0x7a, 0x7e, 0xd6, 0x3d, 0x25, 0x92, 0x7f, 0x86, 0x10, 0xbe,
-	0xae, 0x85, 0x32, 0x14, 0xeb, 0x12, 0x60, 0x9d, 0x57, 0x75, 0x56, 0xc8, 0xae, 0x31, 0x54, 0xdd,
-	0x98, 0x87, 0xc8, 0x5c, 0x23, 0xc1, 0x12, 0x38, 0x21, 0xf3, 0xaa, 0xab, 0xea, 0x32, 0xab, 0x4a,
-	0xaa, 0x2e, 0xe4, 0x11, 0x92, 0x3f, 0x22, 0xb7, 0x2c, 0xd9, 0xf7, 0x40, 0x2f, 0x64, 0xd8, 0xf3,
-	0x78, 0x38, 0xf3, 0xe6, 0xd1, 0xe2, 0x22, 0xb5, 0x03, 0x49, 0xfb, 0x81, 0xa4, 0x77, 0xfd, 0x40,
-	0x78, 0x80, 0xce, 0x08, 0xd9, 0x0c, 0xa6, 0xf6, 0x45, 0xa1, 0x0d, 0xc6, 0x1e, 0x51, 0x6c, 0xaa,
-	0xe7, 0x4e, 0x68, 0xb3, 0x2c, 0x31, 0x7d, 0x9b, 0x6b, 0x7d, 0x48, 0x3f, 0xb6, 0xe9, 0x91, 0x3c,
-	0x4a, 0x4f, 0x3e, 0x94, 0x7e, 0xf2, 0xf1, 0xf4, 0xe8, 0x4c, 0xe9, 0xbf, 0x82, 0x33, 0x4c, 0xd5,
-	0x29, 0x91, 0x6d, 0x85, 0xd6, 0xf9, 0x46, 0xc4, 0x3e, 0x85, 0x3f, 0x75, 0xf4, 0xad, 0x65, 0xb1,
-	0x47, 0xb6, 0x80, 0xba, 0x6a, 0x3e, 0xc4, 0x81, 0x9d, 0x20, 0x31, 0xbf, 0x56, 0xcd, 0x07, 0xf6,
-	0x25, 0x9c, 0x1d, 0xcc, 0x99, 0x11, 0x0f, 0x26, 0x0e, 0xc9, 0xe7, 0x64, 0xef, 0x73, 0x27, 0x1e,
-	0x0c, 0xfb, 0x02, 0x4e, 0xad, 0x5f, 0xa7, 0x6a, 0xeb, 0x06, 0xe4, 0x36, 0x25, 0xf6, 0x5e, 0xd5,
-	0xe4, 0x75, 0x05, 0xcf, 0xeb, 0x9c, 0x3a, 0xf2, 0xb4, 0xf1, 0x11, 0xf9, 0x3e, 0xb3, 0xb6, 0x5f,
-	0x0e, 0xed, 0x4f, 0xfe, 0xf2, 0x60, 0x8a, 0xed, 0xba, 0x15, 0x26, 0xc7, 0x4d, 0x60, 0x9f, 0x41,
-	0x48, 0xef, 0x1f, 0xed, 0x5b, 0x80, 0x44, 0xbf, 0x6e, 0xab, 0x6e, 0x93, 0x15, 0x72, 0xdb, 0xca,
-	0x46, 0x34, 0x86, 0x06, 0x3a, 0xc6, 0x1a, 0x36, 0xd7, 0x3d, 0xc7, 0x9e, 0xc3, 0x58, 0xee, 0x1a,
-	0xa1, 0x68, 0x9a, 0x21, 0xb7, 0x80, 0x9d, 0xc2, 0xa0, 0x28, 0xe2, 0xd1, 0x6c, 0x38, 0x0f, 0xf9,
-	0xa0, 0x28, 0xb0, 0x2d, 0x42, 0x29, 0xa9, 0x32, 0xf3, 0xd8, 0x0a, 0x37, 0x99, 0x90, 0x98, 0xbb,
-	0xc7, 0x56, 0x24, 0x7f, 0x78, 0x30, 0xb9, 0x96, 0x75, 0xb7, 0x6d, 0x30, 0x1e, 0x7d, 0x87, 0xab,
-	0xc6, 0x82, 0xfd, 0xc5, 0x0d, 0x9e, 0x5e, 0x9c, 0x36, 0xb9, 0x32, 0xa2, 0xa4, 0xdc, 0x1e, 0xef,
-	0x21, 0xc6, 0x10, 0x0f, 0x46, 0xe5, 0xae, 0x00, 0x0b, 0xd8, 0x4b, 0x88, 0xde, 0x4b, 0x53, 0x57,
-	0xb4, 0x40, 0xda, 0x15, 0x01, 0x8e, 0x5a, 0x96, 0x3a, 0xf9, 0x73, 0x00, 0x43, 0x2e, 0x77, 0xff,
-	0x7b, 0xde, 0xa7, 0x30, 0xd8, 0x6f, 0xf4, 0xa0, 0x2a, 0x31, 0xb9, 0x12, 0xba, 0xab, 0x8d, 0xbd,
-	0xea, 0x31, 0xef, 0x21, 0xfb, 0x14, 0x82, 0x42, 0xd4, 0x35, 0xe5, 0xb0, 0xf9, 0x7d, 0xc4, 0xcb,
-	0x52, 0xb3, 0x0b, 0x08, 0xdc, 0xf6, 0x60, 0x7a, 0x34, 0xed, 0x31, 0xaa, 0xc4, 0x96, 0xd4, 0x25,
-	0xf6, 0xc9, 0xe2, 0x10, 0x7b, 0x05, 0xbe, 0x7d, 0xd2, 0x71, 0x40, 0x67, 0xeb, 0xa7, 0x56, 0x85,
-	0x78, 0xcf, 0xe3, 0xe7, 0x56, 0x85, 0x6c, 0x74, 0x1c, 0xda, 0xcf, 0x25, 0xc0, 0x3e, 0x81, 0x09,
-	0x4e, 0xaf, 0x2a, 0x63, 0xb0, 0xf4, 0xaa, 0xdb, 0x2c, 0x4b, 0xf6, 0x35, 0x40, 0x8e, 0x17, 0x9d,
-	0x55, 0xcd, 0x5a, 0xd2, 0xa6, 0x44, 0x0b, 0x48, 0xf7, 0x47, 0xce, 0xc3, 0xbc, 0x7f, 0xbc, 0x19,
-	0x05, 0x93, 0x73, 0x3f, 0xf9, 0x7b, 0x08, 0xa3, 0x37, 0xaa, 0x2a, 0xb1, 0x92, 0x82, 0x66, 0xa4,
-	0x9d, 0x80, 0xf8, 0xa9, 0x9d, 0x19, 0xef, 0x79, 0x16, 0xc3, 0x48, 0xc9, 0x9d, 0x55, 0xc0, 0x68,
-	0x31, 0x4a, 0xb9, 0xdc, 0x71, 0x62, 0xec, 0xaa, 0x6a, 0x93, 0xd9, 0xdc, 0xdb, 0x27, 0x1a, 0xe0,
-	0xe1, 0xaa, 0x6a, 0x43, 0x35, 0xdc, 0xf6, 0x07, 0x9f, 0xc0, 0xc4, 0xaa, 0x2f, 0x9d, 0x3a, 0xd6,
-	0x88, 0x8b, 0xfb, 0x46, 0xc9, 0xae, 0xe5, 0xce, 0xc2, 0xbe, 0x01, 0x7a, 0x91, 0x22, 0x65, 0x56,
-	0xbb, 0x4a, 0x3a, 0x6b, 0x8f, 0x9f, 0xa1, 0x01, 0x03, 0x59, 0x8d, 0x2b, 0xd9, 0xb7, 0x10, 0x39,
-	0x21, 0xa4, 0x0f, 0xb7, 0xbd, 0x8c, 0xd2, 0x83, 0x54, 0x72, 0xe8, 0x0e, 0xb2, 0xb9, 0x80, 0x13,
-	0xba, 0x8b, 0xad, 0x3b, 0x14, 0x6a, 0x6d, 0xb4, 0x38, 0x49, 0x8f, 0xaf, 0x87, 0x4f, 0xcd, 0xf1,
-	0x2d, 0x25, 0xe0, 0x17, 0x75, 0xa7, 0x8d, 0x50, 0xd4, 0xf1, 0x68, 0x11, 0xa4, 0xd7, 0x16, 0xf3,
-	0xde, 0xc0, 0x5e, 0xc3, 0xe5, 0x56, 0x6a, 0x93, 0x29, 0x51, 0x88, 0xc6, 0x64, 0x8e, 0xce, 0xf6,
-	0x7f, 0x41, 0x34, 0x10, 0x8f, 0x5f, 0xa0, 0x13, 0x27, 0x1f, 0x17, 0x62, 0x2f, 0x4a, 0x37, 0xa3,
-	0x60, 0x7c, 0x3e, 0xb9, 0x19, 0x05, 0xfe, 0x79, 0x90, 0x28, 0xf0, 0x9d, 0x1d, 0xb7, 0x9b, 0x2a,
-	0xc6, 0xbf, 0xba, 0x4e, 0x3b, 0x75, 0x06, 0xa4, 0xde, 0x11, 0x83, 0x1b, 0xdb, 0x4b, 0x97, 0x5d,
-	0xe3, 0x1e, 0x62, 0x6b, 0xfa, 0x42, 0x94, 0xdc, 0xd1, 0x3e, 0x63, 0x6b, 0xfa, 0xe2, 0xe5, 0x8e,
-	0x43, 0xb1, 0x7f, 0x4e, 0x7e, 0x06, 0x38, 0x58, 0xd8, 0x2b, 0x98, 0x96, 0x95, 0x6e, 0xeb, 0xfc,
-	0xf1, 0x58, 0x43, 0x22, 0xc7, 0x91, 0x8c, 0xe0, 0x7a, 0x36, 0xa5, 0x78, 0x70, 0xff, 0x8b, 0x16,
-	0xac, 0x26, 0xa4, 0xb7, 0xdf, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x79, 0x6f, 0x11, 0x9c,
-	0x07, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x96, 0xe7, 0xd7, 0x2e, 0x4f, 0x7e, 0xb6, 0xb5, 0xac, 0x4c, 0x50, 0xb4, 0xb3, 0x06, 0xc1,
+	0x80, 0x90, 0x23, 0x0d, 0x07, 0x2e, 0x5c, 0x96, 0x00, 0xab, 0x89, 0xc8, 0x6a, 0xd5, 0x9b, 0x9c,
+	0xad, 0x1e, 0xbb, 0x33, 0x6b, 0xad, 0xc7, 0x6d, 0x75, 0xb7, 0x99, 0xe4, 0xcc, 0x33, 0x70, 0xe0,
+	0x4d, 0x78, 0x09, 0xde, 0x09, 0x55, 0x75, 0x7b, 0x32, 0x41, 0x48, 0x9c, 0xc6, 0xdf, 0x57, 0xe5,
+	0xaa, 0x72, 0xfd, 0x7c, 0x03, 0xb1, 0xb1, 0xc2, 0xca, 0xac, 0xd5, 0xca, 0xaa, 0xb3, 0x97, 0x1b,
+	0xa5, 0x36, 0xb5, 0xbc, 0x20, 0xb4, 0xee, 0xee, 0x2e, 0x6c, 0xb5, 0x95, 0xc6, 0x8a, 0x6d, 0xeb,
+	0x1d, 0x5e, 0xb4, 0xeb, 0x8b, 0x42, 0x35, 0x77, 0xd5, 0xc6, 0xff, 0x38, 0x3e, 0x7d, 0x0b, 0x93,
+	0x6b, 0x69, 0x75, 0x55, 0x30, 0x06, 0xa3, 0x46, 0x6c, 0x65, 0x12, 0xcc, 0x83, 0x45, 0xc4, 0xe9,
+	0x99, 0x25, 0x30, 0xad, 0x9a, 0xb2, 0x2a, 0xa4, 0x49, 0x06, 0xf3, 0xe1, 0x62, 0xcc, 0x7b, 0xc8,
+	0x5e, 0xc0, 0xe4, 0x37, 0x51, 0x77, 0xd2, 0x24, 0xc3, 0xf9, 0x70, 0x11, 0x70, 0x8f, 0xd2, 0x5b,
+	0x38, 0xb9, 0x6d, 0x4b, 0x61, 0xe5, 0xbb, 0x0f, 0xc2, 0xc8, 0x9f, 0x84, 0x15, 0xec, 0x1c, 0xa0,
+	0x45, 0x90, 0x1f, 0x84, 0x8f, 0x88, 0x79, 0x8b, 0x39, 0x3e, 0x87, 0x23, 0x67, 0x36, 0xb2, 0x50,
+	0x4d, 0x89, 0x99, 0x82, 0x45, 0xc0, 0x67, 0x44, 0xbe, 0x77, 0x5c, 0x7a, 0x05, 0xe0, 0xc2, 0xae,
+	0x9a, 0x3b, 0xc5, 0x7e, 0x80, 0x67, 0x1d, 0xa1, 0xdc, 0xbd, 0x59, 0x0a, 0x2b, 0x92, 0x60, 0x3e,
+	0x5c, 0xc4, 0xcb, 0xd3, 0xec, 0x5f, 0xe9, 0xf9, 0x49, 0xf7, 0x94, 0x48, 0xff, 0x1e, 0x42, 0xf4,
+	0xba, 0x96, 0xda, 0x52, 0xac, 0x73, 0x80, 0x3b, 0x51, 0xd5, 0x79, 0xa1, 0xba, 0xc6, 0x52, 0x75,
+	0x63, 0x1e, 0x21, 0x73, 0x89, 0x04, 0x4b, 0xe1, 0x88, 0xcc, 0xeb, 0xae, 0xaa, 0xcb, 0xbc, 0x2a,
+	0xa9, 0xba, 0x88, 0xc7, 0x48, 0xfe, 0x88, 0xdc, 0xaa, 0x64, 0xdf, 0x03, 0xbd, 0x90, 0x63, 0xcf,
+	0x93, 0xe1, 0x3c, 0x58, 0xc4, 0xcb, 0xb3, 0xcc, 0x0d, 0x24, 0xeb, 0x07, 0x92, 0xdd, 0xf4, 0x03,
+	0xe1, 0x21, 0x3a, 0x23, 0x64, 0x73, 0x98, 0xb9, 0x17, 0xa5, 0xb1, 0x18, 0x7b, 0x44, 0xb1, 0xa9,
+	0x9e, 0x1b, 0x69, 0xec, 0xaa, 0xc4, 0xf4, 0xad, 0x30, 0xe6, 0x31, 0xfd, 0xd8, 0xa5, 0x47, 0xf2,
+	0x20, 0x3d, 0xf9, 0x50, 0xfa, 0xc9, 0xff, 0xa7, 0x47, 0x67, 0x4a, 0xff, 0x15, 0x9c, 0x60, 0xaa,
+	0x4e, 0xcb, 0x7c, 0x2b, 0x8d, 0x11, 0x1b, 0x99, 0x4c, 0x29, 0xfc, 0xb1, 0xa7, 0xaf, 0x1d, 0x8b,
+	0x3d, 0x72, 0x05, 0xd4, 0x55, 0xf3, 0x31, 0x09, 0xdd, 0x04, 0x89, 0xf9, 0xb5, 0x6a, 0x3e, 0xb2,
+	0x2f, 0xe1, 0xe4, 0xd1, 0x9c, 0x5b, 0x79, 0x6f, 0x93, 0x88, 0x7c, 0x8e, 0xf6, 0x3e, 0x37, 0xf2,
+	0xde, 0xb2, 0x2f, 0xe0, 0xd8, 0xf9, 0x75, 0xba, 0x76, 0x6e, 0x40, 0x6e, 0x33, 0x62, 0x6f, 0x75,
+	0x4d, 0x5e, 0x17, 0xf0, 0xbc, 0x16, 0xd4, 0x91, 0xa7, 0x8d, 0x8f, 0xc9, 0xf7, 0x99, 0xb3, 0xfd,
+	0xf2, 0xd8, 0xfe, 0xf4, 0x8f, 0x00, 0x66, 0xd8, 0xae, 0x6b, 0x69, 0x05, 0x6e, 0x02, 0xfb, 0x0c,
+	0x22, 0x7a, 0xff, 0x60, 0xdf, 0x42, 0x24, 0xfa, 0x75, 0x5b, 0x77, 0x9b, 0xbc, 0x50, 0xdb, 0x56,
+	0x35, 0xb2, 0xb1, 0x34, 0xd0, 0x31, 0xd6, 0xb0, 0xb9, 0xec, 0x39, 0xf6, 0x1c, 0xc6, 0x6a, 0xd7,
+	0x48, 0x4d, 0xd3, 0x8c, 0xb8, 0x03, 0xec, 0x18, 0x06, 0x45, 0x91, 0x8c, 0xe6, 0xc3, 0x45, 0xc4,
+	0x07, 0x45, 0x81, 0x6d, 0x91, 0x5a, 0x2b, 0x9d, 0xdb, 0x87, 0x56, 0xfa, 0xc9, 0x44, 0xc4, 0xdc,
+	0x3c, 0xb4, 0x32, 0xfd, 0x3d, 0x80, 0xc9, 0xa5, 0xaa, 0xbb, 0x6d, 0x83, 0xf1, 0xe8, 0x3b, 0x7c,
+	0x35, 0x0e, 0xec, 0x2f, 0x6e, 0xf0, 0xf4, 0xe2, 0x8c, 0x15, 0xda, 0xca, 0x92, 0x72, 0x07, 0xbc,
+	0x87, 0x18, 0x43, 0xde, 0x5b, 0x2d, 0x7c, 0x01, 0x0e, 0xb0, 0x97, 0x10, 0x7f, 0x50, 0xb6, 0xae,
+	0x68, 0x81, 0x8c, 0x2f, 0x02, 0x3c, 0xb5, 0x2a, 0x4d, 0xfa, 0xd7, 0x00, 0x86, 0x5c, 0xed, 0xfe,
+	0xf3, 0xbc, 0x8f, 0x61, 0xb0, 0xdf, 0xe8, 0x41, 0x55, 0x62, 0x72, 0x2d, 0x4d, 0x57, 0x5b, 0x77,
+	0xd5, 0x63, 0xde, 0x43, 0xf6, 0x29, 0x84, 0x85, 0xac, 0x6b, 0xca, 0xe1, 0xf2, 0x4f, 0x11, 0xaf,
+	0x4a, 0xc3, 0xce, 0x20, 0xf4, 0xdb, 0x83, 0xe9, 0xd1, 0xb4, 0xc7, 0xa8, 0x12, 0x5b, 0x52, 0x97,
+	0x64, 0x4a, 0x16, 0x8f, 0xd8, 0x2b, 0x98, 0xba, 0x27, 0x93, 0x84, 0x74, 0xb6, 0xd3, 0xcc, 0xa9,
+	0x10, 0xef, 0x79, 0xfc, 0xdc, 0xaa, 0x50, 0x8d, 0x49, 0x22, 0xf7, 0xb9, 0x04, 0xd8, 0x27, 0x30,
+	0xc1, 0xe9, 0x55, 0x65, 0x02, 0x8e, 0x5e, 0x77, 0x9b, 0x55, 0xc9, 0xbe, 0x06, 0x10, 0x78, 0xd1,
+	0x79, 0xd5, 0xdc, 0x29, 0xda, 0x94, 0x78, 0x09, 0xd9, 0xfe, 0xc8, 0x79, 0x24, 0xfa, 0x47, 0x9c,
+	0x7f, 0x67, 0xa4, 0xce, 0x5b, 0xad, 0x5a, 0xa9, 0xed, 0x43, 0x32, 0xa3, 0x40, 0x33, 0x24, 0xdf,
+	0x79, 0xee, 0x6a, 0x14, 0x4e, 0x4e, 0xa7, 0xe9, 0x9f, 0x43, 0x18, 0xbd, 0xd1, 0x55, 0x89, 0xe5,
+	0x16, 0x34, 0x48, 0xe3, 0x55, 0x66, 0x9a, 0xb9, 0xc1, 0xf2, 0x9e, 0x67, 0x09, 0x8c, 0xb4, 0xda,
+	0x39, 0x99, 0x8c, 0x97, 0xa3, 0x8c, 0xab, 0x1d, 0x27, 0xc6, 0xed, 0xb3, 0xb1, 0xb9, 0x2b, 0x70,
+	0xfb, 0x44, 0x28, 0x02, 0xdc, 0x67, 0x63, 0xa9, 0xd0, 0xeb, 0x5e, 0x15, 0x52, 0x98, 0x38, 0x89,
+	0x4e, 0x46, 0xfe, 0x43, 0x70, 0xbb, 0xdf, 0x68, 0xd5, 0xb5, 0xdc, 0x5b, 0xd8, 0x37, 0x40, 0x2f,
+	0x52, 0xa4, 0xdc, 0x09, 0x5c, 0x49, 0xb7, 0x1f, 0xf0, 0x13, 0x34, 0x60, 0x20, 0x27, 0x84, 0x25,
+	0xfb, 0x16, 0x62, 0xaf, 0x96, 0xd4, 0x1d, 0xd7, 0xf0, 0x38, 0x7b, 0xd4, 0x53, 0x0e, 0xdd, 0xfe,
+	0x99, 0x2d, 0xe1, 0x88, 0x8e, 0x67, 0xeb, 0xaf, 0x89, 0xfa, 0x1f, 0x2f, 0x8f, 0xb2, 0xc3, 0x13,
+	0xe3, 0x33, 0x7b, 0x80, 0x58, 0x0a, 0xd3, 0xa2, 0xee, 0x8c, 0x95, 0x9a, 0xc6, 0x12, 0x2f, 0xc3,
+	0xec, 0xd2, 0x61, 0xde, 0x1b, 0xd8, 0x6b, 0x38, 0xdf, 0x2a, 0x63, 0x73, 0x2d, 0x0b, 0xd9, 0xd8,
+	0xdc, 0xd3, 0xf9, 0xfe, 0x7f, 0x8a, 0xa6, 0x16, 0xf0, 0x33, 0x74, 0xe2, 0xe4, 0xe3, 0x43, 0xec,
+	0x95, 0xeb, 0x6a, 0x14, 0x8e, 0x4f, 0x27, 0x57, 0xa3, 0x70, 0x7a, 0x1a, 0xa6, 0x1a, 0xa6, 0xde,
+	0x8e, 0x27, 0x40, 0x15, 0x1b, 0x2b, 0x6c, 0x67, 0xbc, 0x84, 0x03, 0x52, 0xef, 0x89, 0xc1, 0xb5,
+	0xee, 0xf5, 0xcd, 0xed, 0x7a, 0x0f, 0xb1, 0x35, 0x7d, 0x21, 0x5a, 0xed, 0x92, 0xa1, 0x6f, 0x4d,
+	0x5f, 0xbc, 0xda, 0x71, 0x28, 0xf6, 0xcf, 0xe9, 0xcf, 0x00, 0x8f, 0x16, 0xf6, 0x0a, 0x66, 0x65,
+	0x65, 0xda, 0x5a, 0x3c, 0x1c, 0x0a, 0x4d, 0xec, 0x39, 0xd2, 0x1a, 0xdc, 0xe1, 0xa6, 0x94, 0xf7,
+	0xfe, 0xcf, 0xd3, 0x81, 0xf5, 0x84, 0x44, 0xf9, 0xbb, 0x7f, 0x06, 0x00, 0xe3, 0xb7, 0xd7, 0x1f,
+	0xc1, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,417 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package api serves the configuration, summaries and grid state of TestGrid over HTTP.
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	apipb "github.com/GoogleCloudPlatform/testgrid/pb/api"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/store"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Prefix is the path under which the server responds to requests.
+const Prefix = "/api/v1/"
+
+const (
+	protoType = "application/x-protobuf"
+	jsonType  = "application/json"
+)
+
+var (
+	errNotFound    = errors.New("not found")
+	errNotModified = errors.New("not modified")
+)
+
+// Server serves the read API:
+//
+//	/api/v1/dashboard-groups
+//	/api/v1/dashboard-groups/<group>
+//	/api/v1/dashboards
+//	/api/v1/dashboards/<dashboard>/tabs
+//	/api/v1/dashboards/<dashboard>/tab-summaries
+//	/api/v1/dashboards/<dashboard>/tab-summaries/<tab>
+//	/api/v1/dashboards/<dashboard>/tabs/<tab>/healthiness
+//	/api/v1/dashboards/<dashboard>/tabs/<tab>/rows
+//	/api/v1/dashboards/<dashboard>/tabs/<tab>/columns
+//
+// Responses are JSON unless the request sets ?format=proto or accepts application/x-protobuf.
+// The ETag of each response is the generation of the object it was read from,
+// omitted when the storage client does not report generations.
+type Server struct {
+	store         *store.Reader
+	gridPrefix    string
+	summaryPrefix string
+}
+
+// NewServer returns a server reading the configuration at configPath, as well as
+// grid state and summaries under gridPrefix and summaryPrefix relative to the configuration.
+func NewServer(client gcs.Opener, configPath gcs.Path, gridPrefix, summaryPrefix string) *Server {
+	return &Server{
+		store:         store.NewReader(client, configPath),
+		gridPrefix:    gridPrefix,
+		summaryPrefix: summaryPrefix,
+	}
+}
+
+// getter returns a resource and the generation of the object it was read from.
+//
+// Returns errNotModified without reading the object when cached is true for its current generation.
+type getter func(ctx context.Context, cached func(gen int64) bool) (proto.Message, int64, error)
+
+// checkModified returns errNotModified and the generation of the named object when it is cached.
+func (s *Server) checkModified(ctx context.Context, name string, cached func(int64) bool) (int64, error) {
+	gen, ok, err := s.store.Generation(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if ok && cached(gen) {
+		return gen, errNotModified
+	}
+	return 0, nil
+}
+
+// readSummary returns the summary of the named dashboard and its generation.
+func (s *Server) readSummary(ctx context.Context, dashboard string, cached func(int64) bool) (*summarypb.DashboardSummary, int64, error) {
+	name := path.Join(s.summaryPrefix, summarizer.SummaryPath(dashboard))
+	if gen, err := s.checkModified(ctx, name, cached); err != nil {
+		return nil, gen, err
+	}
+	buf, gen, err := s.store.Read(ctx, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	var sum summarypb.DashboardSummary
+	if err := proto.Unmarshal(buf, &sum); err != nil {
+		return nil, 0, fmt.Errorf("parse summary: %w", err)
+	}
+	return &sum, gen, nil
+}
+
+// readTabSummary returns the summary of the named tab and the generation of the dashboard summary.
+func (s *Server) readTabSummary(ctx context.Context, dashboard, tab string, cached func(int64) bool) (*summarypb.DashboardTabSummary, int64, error) {
+	sum, gen, err := s.readSummary(ctx, dashboard, cached)
+	if err != nil {
+		return nil, gen, err
+	}
+	for _, ts := range sum.TabSummaries {
+		if ts.DashboardTabName == tab {
+			return ts, gen, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("tab %s: %w", tab, errNotFound)
+}
+
+// readGrid returns the grid state of the named dashboard tab and its generation.
+func (s *Server) readGrid(ctx context.Context, dashboard, tab string, cached func(int64) bool) (*statepb.Grid, int64, error) {
+	cfg, _, err := s.store.Config(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	t := config.FindDashboardTab(dashboard, tab, cfg)
+	if t.GetTestGroupName() == "" {
+		return nil, 0, fmt.Errorf("dashboard %s tab %s: %w", dashboard, tab, errNotFound)
+	}
+	name := path.Join(s.gridPrefix, t.GetTestGroupName())
+	if gen, err := s.checkModified(ctx, name, cached); err != nil {
+		return nil, gen, err
+	}
+	return s.store.Grid(ctx, name)
+}
+
+// link returns the API path of the named resource.
+func link(parts ...string) string {
+	escaped := make([]string, 0, len(parts))
+	for _, p := range parts {
+		escaped = append(escaped, url.PathEscape(p))
+	}
+	return Prefix + strings.Join(escaped, "/")
+}
+
+func (s *Server) listDashboardGroups(ctx context.Context) (proto.Message, int64, error) {
+	cfg, gen, err := s.store.Config(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	var resp apipb.ListDashboardGroupsResponse
+	for _, dg := range cfg.DashboardGroups {
+		resp.DashboardGroups = append(resp.DashboardGroups, &apipb.Resource{
+			Name: dg.Name,
+			Link: link("dashboard-groups", dg.Name),
+		})
+	}
+	return &resp, gen, nil
+}
+
+func (s *Server) listDashboards(ctx context.Context, group string) (proto.Message, int64, error) {
+	cfg, gen, err := s.store.Config(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	var names []string
+	if group == "" {
+		for _, d := range cfg.Dashboards {
+			names = append(names, d.Name)
+		}
+	} else {
+		var found bool
+		for _, dg := range cfg.DashboardGroups {
+			if dg.Name == group {
+				names = dg.DashboardNames
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, 0, fmt.Errorf("dashboard group %s: %w", group, errNotFound)
+		}
+	}
+	var resp apipb.ListDashboardsResponse
+	for _, name := range names {
+		resp.Dashboards = append(resp.Dashboards, &apipb.Resource{
+			Name: name,
+			Link: link("dashboards", name, "tabs"),
+		})
+	}
+	return &resp, gen, nil
+}
+
+func (s *Server) listTabs(ctx context.Context, dashboard string) (proto.Message, int64, error) {
+	cfg, gen, err := s.store.Config(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	dash := config.FindDashboard(dashboard, cfg)
+	if dash == nil {
+		return nil, 0, fmt.Errorf("dashboard %s: %w", dashboard, errNotFound)
+	}
+	var resp apipb.ListTabsResponse
+	for _, tab := range dash.DashboardTab {
+		resp.DashboardTabs = append(resp.DashboardTabs, &apipb.Resource{
+			Name: tab.Name,
+			Link: link("dashboards", dashboard, "tabs", tab.Name, "rows"),
+		})
+	}
+	return &resp, gen, nil
+}
+
+func (s *Server) listTabSummaries(ctx context.Context, dashboard string, cached func(int64) bool) (proto.Message, int64, error) {
+	sum, gen, err := s.readSummary(ctx, dashboard, cached)
+	if err != nil {
+		return nil, gen, err
+	}
+	return &apipb.ListTabSummariesResponse{TabSummaries: sum.TabSummaries}, gen, nil
+}
+
+func (s *Server) getTabSummary(ctx context.Context, dashboard, tab string, cached func(int64) bool) (proto.Message, int64, error) {
+	return s.readTabSummary(ctx, dashboard, tab, cached)
+}
+
+func (s *Server) getHealthiness(ctx context.Context, dashboard, tab string, cached func(int64) bool) (proto.Message, int64, error) {
+	ts, gen, err := s.readTabSummary(ctx, dashboard, tab, cached)
+	if err != nil {
+		return nil, gen, err
+	}
+	if ts.Healthiness == nil {
+		return nil, 0, fmt.Errorf("healthiness of %s: %w", tab, errNotFound)
+	}
+	return ts.Healthiness, gen, nil
+}
+
+func (s *Server) listRows(ctx context.Context, dashboard, tab string, cached func(int64) bool) (proto.Message, int64, error) {
+	grid, gen, err := s.readGrid(ctx, dashboard, tab, cached)
+	if err != nil {
+		return nil, gen, err
+	}
+	return &apipb.ListRowsResponse{Rows: grid.Rows}, gen, nil
+}
+
+func (s *Server) listColumns(ctx context.Context, dashboard, tab string, cached func(int64) bool) (proto.Message, int64, error) {
+	grid, gen, err := s.readGrid(ctx, dashboard, tab, cached)
+	if err != nil {
+		return nil, gen, err
+	}
+	return &apipb.ListColumnsResponse{Columns: grid.Columns}, gen, nil
+}
+
+// route returns the getter for the path, or nil if none match.
+func (s *Server) route(p string) getter {
+	if !strings.HasPrefix(p, Prefix) {
+		return nil
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(p, Prefix), "/"), "/")
+	for _, part := range parts {
+		if part == "" {
+			return nil
+		}
+	}
+	switch {
+	case len(parts) == 1 && parts[0] == "dashboard-groups":
+		return func(ctx context.Context, _ func(int64) bool) (proto.Message, int64, error) {
+			return s.listDashboardGroups(ctx)
+		}
+	case len(parts) == 2 && parts[0] == "dashboard-groups":
+		return func(ctx context.Context, _ func(int64) bool) (proto.Message, int64, error) {
+			return s.listDashboards(ctx, parts[1])
+		}
+	case len(parts) == 1 && parts[0] == "dashboards":
+		return func(ctx context.Context, _ func(int64) bool) (proto.Message, int64, error) {
+			return s.listDashboards(ctx, "")
+		}
+	case len(parts) < 3 || parts[0] != "dashboards":
+		return nil
+	}
+	dashboard := parts[1]
+	switch {
+	case len(parts) == 3 && parts[2] == "tabs":
+		return func(ctx context.Context, _ func(int64) bool) (proto.Message, int64, error) {
+			return s.listTabs(ctx, dashboard)
+		}
+	case len(parts) == 3 && parts[2] == "tab-summaries":
+		return func(ctx context.Context, cached func(int64) bool) (proto.Message, int64, error) {
+			return s.listTabSummaries(ctx, dashboard, cached)
+		}
+	case len(parts) == 4 && parts[2] == "tab-summaries":
+		return func(ctx context.Context, cached func(int64) bool) (proto.Message, int64, error) {
+			return s.getTabSummary(ctx, dashboard, parts[3], cached)
+		}
+	case len(parts) != 5 || parts[2] != "tabs":
+		return nil
+	}
+	tab := parts[3]
+	var get func(context.Context, string, string, func(int64) bool) (proto.Message, int64, error)
+	switch parts[4] {
+	case "healthiness":
+		get = s.getHealthiness
+	case "rows":
+		get = s.listRows
+	case "columns":
+		get = s.listColumns
+	default:
+		return nil
+	}
+	return func(ctx context.Context, cached func(int64) bool) (proto.Message, int64, error) {
+		return get(ctx, dashboard, tab, cached)
+	}
+}
+
+// wantProto returns true if the request prefers a binary proto response.
+func wantProto(r *http.Request) bool {
+	if f := r.URL.Query().Get("format"); f != "" {
+		return f == "proto"
+	}
+	return strings.Contains(r.Header.Get("Accept"), protoType)
+}
+
+// etag returns the entity tag of the response for an object generation.
+//
+// Returns an empty tag for an unknown (zero) generation, which could match any version of the object.
+func etag(gen int64, binary bool) string {
+	if gen == 0 {
+		return ""
+	}
+	if binary {
+		return fmt.Sprintf(`"%d-proto"`, gen)
+	}
+	return fmt.Sprintf(`"%d-json"`, gen)
+}
+
+// matchETag returns true if the If-None-Match header of the request matches the tag.
+//
+// Never matches an empty tag.
+func matchETag(r *http.Request, tag string) bool {
+	if tag == "" {
+		return false
+	}
+	for _, t := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == tag || t == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ServeHTTP writes the requested resource as JSON or a binary proto.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	get := s.route(r.URL.Path)
+	if get == nil {
+		http.NotFound(w, r)
+		return
+	}
+	log := logrus.WithField("path", r.URL.Path)
+	binary := wantProto(r)
+	msg, gen, err := get(r.Context(), func(gen int64) bool {
+		return matchETag(r, etag(gen, binary))
+	})
+	switch {
+	case errors.Is(err, errNotModified):
+	case errors.Is(err, errNotFound), errors.Is(err, storage.ErrObjectNotExist):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		log.WithError(err).Error("Failed to read resource")
+		http.Error(w, "failed to read resource", http.StatusInternalServerError)
+		return
+	}
+
+	tag := etag(gen, binary)
+	if tag != "" {
+		w.Header().Set("ETag", tag)
+	}
+	w.Header().Set("Vary", "Accept")
+	if matchETag(r, tag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if binary {
+		buf, err := proto.Marshal(msg)
+		if err != nil {
+			log.WithError(err).Error("Failed to marshal proto")
+			http.Error(w, "failed to marshal response", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", protoType)
+		w.Write(buf)
+		return
+	}
+	w.Header().Set("Content-Type", jsonType)
+	var m jsonpb.Marshaler
+	if err := m.Marshal(w, msg); err != nil {
+		log.WithError(err).Error("Failed to write response")
+	}
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	apipb "github.com/GoogleCloudPlatform/testgrid/pb/api"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func writeFile(t *testing.T, name string, buf []byte) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatalf("Failed to create %s: %v", filepath.Dir(name), err)
+	}
+	if err := ioutil.WriteFile(name, buf, 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
+func marshalOrDie(t *testing.T, msg proto.Message) []byte {
+	buf, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal %T: %v", msg, err)
+	}
+	return buf
+}
+
+// testServer writes a config, summary and grid into a temp dir and serves them.
+func testServer(t *testing.T, client gcs.Opener) (*Server, func()) {
+	dir, err := ioutil.TempDir("", "api")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{Name: "group", GcsPrefix: "bucket/job", DaysOfResults: 1, NumColumnsRecent: 1},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{Name: "tab", TestGroupName: "group"},
+				},
+			},
+			{Name: "other"},
+		},
+		DashboardGroups: []*configpb.DashboardGroup{
+			{Name: "dash-group", DashboardNames: []string{"dash"}},
+		},
+	}
+	cfgBuf, err := config.MarshalBytes(cfg)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "config"), cfgBuf)
+
+	sum := &summarypb.DashboardSummary{
+		TabSummaries: []*summarypb.DashboardTabSummary{
+			{
+				DashboardName:    "dash",
+				DashboardTabName: "tab",
+				Status:           "good",
+				Healthiness:      &summarypb.HealthinessInfo{AverageFlakiness: 0.5},
+			},
+		},
+	}
+	writeFile(t, filepath.Join(dir, "summary", summarizer.SummaryPath("dash")), marshalOrDie(t, sum))
+
+	grid := &statepb.Grid{
+		Columns: []*statepb.Column{{Build: "1", Name: "1", Started: 1000}},
+		Rows:    []*statepb.Row{{Name: "test", Id: "test", Results: []int32{1, 1}}},
+	}
+	var zbuf bytes.Buffer
+	zw := zlib.NewWriter(&zbuf)
+	zw.Write(marshalOrDie(t, grid))
+	zw.Close()
+	writeFile(t, filepath.Join(dir, "grid", "group"), zbuf.Bytes())
+
+	var configPath gcs.Path
+	if err := configPath.Set("file://" + filepath.ToSlash(dir) + "/config"); err != nil {
+		t.Fatalf("Failed to create config path: %v", err)
+	}
+	return NewServer(client, configPath, "grid", "summary"), func() { os.RemoveAll(dir) }
+}
+
+func TestServeHTTP(t *testing.T) {
+	server, cleanup := testServer(t, gcs.NewLocalClient())
+	defer cleanup()
+
+	cases := []struct {
+		name     string
+		path     string
+		code     int
+		expected proto.Message
+	}{
+		{
+			name: "dashboard groups",
+			path: "/api/v1/dashboard-groups",
+			code: http.StatusOK,
+			expected: &apipb.ListDashboardGroupsResponse{
+				DashboardGroups: []*apipb.Resource{
+					{Name: "dash-group", Link: "/api/v1/dashboard-groups/dash-group"},
+				},
+			},
+		},
+		{
+			name: "dashboards in a group",
+			path: "/api/v1/dashboard-groups/dash-group",
+			code: http.StatusOK,
+			expected: &apipb.ListDashboardsResponse{
+				Dashboards: []*apipb.Resource{
+					{Name: "dash", Link: "/api/v1/dashboards/dash/tabs"},
+				},
+			},
+		},
+		{
+			name: "missing dashboard group",
+			path: "/api/v1/dashboard-groups/missing",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "dashboards",
+			path: "/api/v1/dashboards",
+			code: http.StatusOK,
+			expected: &apipb.ListDashboardsResponse{
+				Dashboards: []*apipb.Resource{
+					{Name: "dash", Link: "/api/v1/dashboards/dash/tabs"},
+					{Name: "other", Link: "/api/v1/dashboards/other/tabs"},
+				},
+			},
+		},
+		{
+			name: "tabs",
+			path: "/api/v1/dashboards/dash/tabs",
+			code: http.StatusOK,
+			expected: &apipb.ListTabsResponse{
+				DashboardTabs: []*apipb.Resource{
+					{Name: "tab", Link: "/api/v1/dashboards/dash/tabs/tab/rows"},
+				},
+			},
+		},
+		{
+			name: "missing dashboard",
+			path: "/api/v1/dashboards/missing/tabs",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "tab summaries",
+			path: "/api/v1/dashboards/dash/tab-summaries",
+			code: http.StatusOK,
+			expected: &apipb.ListTabSummariesResponse{
+				TabSummaries: []*summarypb.DashboardTabSummary{
+					{
+						DashboardName:    "dash",
+						DashboardTabName: "tab",
+						Status:           "good",
+						Healthiness:      &summarypb.HealthinessInfo{AverageFlakiness: 0.5},
+					},
+				},
+			},
+		},
+		{
+			name: "tab summary",
+			path: "/api/v1/dashboards/dash/tab-summaries/tab",
+			code: http.StatusOK,
+			expected: &summarypb.DashboardTabSummary{
+				DashboardName:    "dash",
+				DashboardTabName: "tab",
+				Status:           "good",
+				Healthiness:      &summarypb.HealthinessInfo{AverageFlakiness: 0.5},
+			},
+		},
+		{
+			name: "missing summary",
+			path: "/api/v1/dashboards/other/tab-summaries",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "missing tab summary",
+			path: "/api/v1/dashboards/dash/tab-summaries/missing",
+			code: http.StatusNotFound,
+		},
+		{
+			name:     "healthiness",
+			path:     "/api/v1/dashboards/dash/tabs/tab/healthiness",
+			code:     http.StatusOK,
+			expected: &summarypb.HealthinessInfo{AverageFlakiness: 0.5},
+		},
+		{
+			name: "rows",
+			path: "/api/v1/dashboards/dash/tabs/tab/rows",
+			code: http.StatusOK,
+			expected: &apipb.ListRowsResponse{
+				Rows: []*statepb.Row{{Name: "test", Id: "test", Results: []int32{1, 1}}},
+			},
+		},
+		{
+			name: "columns",
+			path: "/api/v1/dashboards/dash/tabs/tab/columns",
+			code: http.StatusOK,
+			expected: &apipb.ListColumnsResponse{
+				Columns: []*statepb.Column{{Build: "1", Name: "1", Started: 1000}},
+			},
+		},
+		{
+			name: "missing tab",
+			path: "/api/v1/dashboards/dash/tabs/missing/rows",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "unknown resource",
+			path: "/api/v1/dashboards/dash/tabs/tab/unknown",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "empty path segment",
+			path: "/api/v1/dashboards//tabs",
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, binary := range []bool{false, true} {
+				req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+				if binary {
+					req.Header.Set("Accept", protoType)
+				}
+				rec := httptest.NewRecorder()
+				server.ServeHTTP(rec, req)
+				if rec.Code != tc.code {
+					t.Fatalf("ServeHTTP(binary=%t) got code %d, want %d: %s", binary, rec.Code, tc.code, rec.Body.String())
+				}
+				if tc.expected == nil {
+					continue
+				}
+				actual := proto.Clone(tc.expected)
+				actual.Reset()
+				if binary {
+					err := proto.Unmarshal(rec.Body.Bytes(), actual)
+					if err != nil {
+						t.Fatalf("Failed to parse proto: %v", err)
+					}
+				} else if err := jsonpb.Unmarshal(rec.Body, actual); err != nil {
+					t.Fatalf("Failed to parse json: %v", err)
+				}
+				if diff := cmp.Diff(actual, tc.expected, cmp.Comparer(proto.Equal)); diff != "" {
+					t.Errorf("ServeHTTP(binary=%t) got unexpected diff (-have, +want):\n%s", binary, diff)
+				}
+			}
+		})
+	}
+}
+
+// openCounter counts the objects a client opens.
+type openCounter struct {
+	gcs.Opener
+	gcs.Stater
+	opened int
+}
+
+func (oc *openCounter) Open(ctx context.Context, path gcs.Path) (io.ReadCloser, error) {
+	oc.opened++
+	return oc.Opener.Open(ctx, path)
+}
+
+func TestETag(t *testing.T) {
+	local := gcs.NewLocalClient()
+	client := &openCounter{Opener: local, Stater: local.(gcs.Stater)}
+	server, cleanup := testServer(t, client)
+	defer cleanup()
+
+	for _, p := range []string{
+		"/api/v1/dashboards",
+		"/api/v1/dashboards/dash/tab-summaries/tab",
+		"/api/v1/dashboards/dash/tabs/tab/rows",
+	} {
+		t.Run(p, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+			tag := rec.Header().Get("ETag")
+			if tag == "" {
+				t.Fatalf("ServeHTTP() failed to set an ETag")
+			}
+
+			opened := client.opened
+			req := httptest.NewRequest(http.MethodGet, p, nil)
+			req.Header.Set("If-None-Match", tag)
+			rec = httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+			if rec.Code != http.StatusNotModified {
+				t.Errorf("ServeHTTP(If-None-Match: %s) got code %d, want %d", tag, rec.Code, http.StatusNotModified)
+			}
+			if actual := rec.Header().Get("ETag"); actual != tag {
+				t.Errorf("ServeHTTP(If-None-Match: %s) got ETag %q, want %q", tag, actual, tag)
+			}
+			if client.opened != opened {
+				t.Errorf("ServeHTTP(If-None-Match: %s) opened %d objects, want none", tag, client.opened-opened)
+			}
+
+			req = httptest.NewRequest(http.MethodGet, p+"?format=proto", nil)
+			req.Header.Set("If-None-Match", tag)
+			rec = httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Errorf("ServeHTTP(format=proto, If-None-Match: %s) got code %d, want %d", tag, rec.Code, http.StatusOK)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != protoType {
+				t.Errorf("ServeHTTP(format=proto) got Content-Type %q, want %q", ct, protoType)
+			}
+		})
+	}
+}
+
+// plainOpener hides the attributes of the objects a client opens, so their generations are unknown.
+type plainOpener struct {
+	gcs.Opener
+}
+
+func (po plainOpener) Open(ctx context.Context, path gcs.Path) (io.ReadCloser, error) {
+	r, err := po.Opener.Open(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(r), nil
+}
+
+func TestETagUnknownGeneration(t *testing.T) {
+	server, cleanup := testServer(t, plainOpener{gcs.NewLocalClient()})
+	defer cleanup()
+
+	for _, p := range []string{
+		"/api/v1/dashboards",
+		"/api/v1/dashboards/dash/tab-summaries/tab",
+		"/api/v1/dashboards/dash/tabs/tab/rows",
+	} {
+		t.Run(p, func(t *testing.T) {
+			for _, match := range []string{"", `"0-json"`, "*"} {
+				req := httptest.NewRequest(http.MethodGet, p, nil)
+				if match != "" {
+					req.Header.Set("If-None-Match", match)
+				}
+				rec := httptest.NewRecorder()
+				server.ServeHTTP(rec, req)
+				if rec.Code != http.StatusOK {
+					t.Errorf("ServeHTTP(If-None-Match: %s) got code %d, want %d", match, rec.Code, http.StatusOK)
+				}
+				if tag := rec.Header().Get("ETag"); tag != "" {
+					t.Errorf("ServeHTTP(If-None-Match: %s) got ETag %q for an unknown generation", match, tag)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package publisher uploads the results of prow builds in GCS to ResultStore.
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Result holds the metadata and junit results of a build.
+type Result struct {
+	Started  gcs.Started
+	Finished gcs.Finished
+	Suites   []gcs.SuitesMeta
+}
+
+// Read downloads the started.json, finished.json and junit artifacts of the build.
+func Read(parent context.Context, client gcs.Downloader, build gcs.Build) (*Result, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	var result Result
+	started, err := build.Started(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("started: %w", err)
+	}
+	result.Started = *started
+	finished, err := build.Finished(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("finished: %w", err)
+	}
+	result.Finished = *finished
+
+	artifacts := make(chan string)
+	suites := make(chan gcs.SuitesMeta)
+	errs := make(chan error, 2)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer close(artifacts)
+		if err := build.Artifacts(ctx, client, artifacts); err != nil {
+			errs <- fmt.Errorf("list: %w", err)
+			cancel()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		defer close(suites)
+		if err := build.Suites(ctx, client, artifacts, suites); err != nil {
+			errs <- fmt.Errorf("download: %w", err)
+			cancel()
+		}
+	}()
+	for suite := range suites {
+		result.Suites = append(result.Suites, suite)
+	}
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+	// Suites arrive in any order, so sort them for stable action IDs.
+	sort.Slice(result.Suites, func(i, j int) bool {
+		return result.Suites[i].Path < result.Suites[j].Path
+	})
+	return &result, nil
+}
+
+// Upload holds the ResultStore resources representing a build.
+//
+// Each build is an invocation with one target named after the job,
+// which has a test action for each junit artifact.
+type Upload struct {
+	ID         string // of the invocation
+	Invocation resultstore.Invocation
+	TargetID   string
+	Target     resultstore.Target
+	Tests      []Test
+}
+
+// Test holds a test action along with its ID.
+type Test struct {
+	ID string
+	resultstore.Test
+}
+
+// InvocationID returns a stable invocation ID for the build.
+//
+// Publishing the same build always uses the same ID.
+func InvocationID(build gcs.Build) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(build.Path.String())).String()
+}
+
+// Convert returns the ResultStore resources of a build in the project.
+func Convert(project string, build gcs.Build, result Result) Upload {
+	start := time.Unix(result.Started.Timestamp, 0)
+	var dur time.Duration
+	if when := result.Finished.Timestamp; when != nil && *when > result.Started.Timestamp {
+		dur = time.Unix(*when, 0).Sub(start)
+	}
+	done := !result.Started.Pending && !result.Finished.Running
+	buildStatus := resultstore.Running
+	var description string
+	if done {
+		if result.Finished.Passed != nil && *result.Finished.Passed {
+			buildStatus = resultstore.Passed
+		} else {
+			buildStatus = resultstore.Failed
+		}
+		description = result.Finished.Result
+	}
+
+	up := Upload{
+		ID: InvocationID(build),
+		Invocation: resultstore.Invocation{
+			Project:     project,
+			Details:     build.Path.String(),
+			Start:       start,
+			Duration:    dur,
+			Status:      buildStatus,
+			Description: description,
+			Files:       []resultstore.File{buildFile(build, "build-log.txt")},
+			Properties:  metadataProperties(result.Finished.Metadata),
+		},
+		TargetID: jobName(build),
+		Target: resultstore.Target{
+			Start:       start,
+			Duration:    dur,
+			Status:      buildStatus,
+			Description: description,
+		},
+	}
+
+	var failures int
+	prefix := build.Path.String()
+	for _, meta := range result.Suites {
+		rel := strings.TrimPrefix(strings.TrimPrefix(meta.Path, prefix), "/")
+		suite := convertSuites(rel, meta.Suites)
+		suite.Files = []resultstore.File{{ID: "junit.xml", ContentType: "text/xml", URL: meta.Path}}
+		testStatus := resultstore.Passed
+		if n := suiteFailures(suite); n > 0 {
+			failures += n
+			testStatus = resultstore.Failed
+		}
+		up.Tests = append(up.Tests, Test{
+			ID: strings.ReplaceAll(rel, "/", "_"),
+			Test: resultstore.Test{
+				Action: resultstore.Action{
+					Start:  start,
+					Status: testStatus,
+				},
+				Suite: suite,
+			},
+		})
+	}
+	if done && failures == 0 && buildStatus == resultstore.Failed {
+		up.Tests = append(up.Tests, Test{
+			ID: "build",
+			Test: resultstore.Test{
+				Action: resultstore.Action{
+					Start:    start,
+					Duration: dur,
+					Status:   resultstore.Failed,
+				},
+				Suite: resultstore.Suite{
+					Name:     "build",
+					Duration: dur,
+					Errors:   []resultstore.Error{{Message: "Build failed outside of test results"}},
+				},
+			},
+		})
+	}
+	return up
+}
+
+// jobName returns the name of the job directory containing the build, such as ci-foo for logs/ci-foo/123.
+func jobName(build gcs.Build) string {
+	return path.Base(path.Dir(strings.TrimSuffix(build.Path.Object(), "/")))
+}
+
+// buildFile returns the named file in the build.
+func buildFile(build gcs.Build, name string) resultstore.File {
+	return resultstore.File{
+		ID:  name,
+		URL: strings.TrimSuffix(build.Path.String(), "/") + "/" + name,
+	}
+}
+
+// metadataProperties returns the string values of the metadata, sorted by key.
+func metadataProperties(md map[string]interface{}) []resultstore.Property {
+	var props []resultstore.Property
+	for key, val := range md {
+		if s, ok := val.(string); ok {
+			props = append(props, resultstore.Property{Key: key, Value: s})
+		}
+	}
+	sort.Slice(props, func(i, j int) bool {
+		return props[i].Key < props[j].Key
+	})
+	return props
+}
+
+// convertSuites returns a suite named after the artifact holding the junit suites.
+func convertSuites(name string, suites junit.Suites) resultstore.Suite {
+	out := resultstore.Suite{Name: name}
+	for _, s := range suites.Suites {
+		child := convertSuite(s)
+		out.Duration += child.Duration
+		out.Suites = append(out.Suites, child)
+	}
+	return out
+}
+
+func convertSuite(suite junit.Suite) resultstore.Suite {
+	out := resultstore.Suite{
+		Name:       suite.Name,
+		Duration:   seconds(suite.Time),
+		Properties: convertProperties(suite.Properties),
+	}
+	for _, s := range suite.Suites {
+		out.Suites = append(out.Suites, convertSuite(s))
+	}
+	for _, r := range suite.Results {
+		out.Cases = append(out.Cases, convertResult(r))
+	}
+	return out
+}
+
+// convertResult converts a junit result into a test case, which fails when the result has a failure.
+func convertResult(r junit.Result) resultstore.Case {
+	c := resultstore.Case{
+		Name:       r.Name,
+		Class:      r.ClassName,
+		Duration:   seconds(r.Time),
+		Result:     resultstore.Completed,
+		Properties: convertProperties(r.Properties),
+	}
+	switch {
+	case r.Failure != nil:
+		c.Failures = []resultstore.Failure{{Message: *r.Failure}}
+	case r.Skipped != nil:
+		c.Result = resultstore.Skipped
+	}
+	return c
+}
+
+func convertProperties(props *junit.Properties) []resultstore.Property {
+	if props == nil {
+		return nil
+	}
+	var out []resultstore.Property
+	for _, p := range props.PropertyList {
+		out = append(out, resultstore.Property{Key: p.Name, Value: p.Value})
+	}
+	return out
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// suiteFailures returns the number of failing cases in the suite and its children.
+func suiteFailures(suite resultstore.Suite) int {
+	var n int
+	for _, c := range suite.Cases {
+		if len(c.Failures) > 0 || len(c.Errors) > 0 {
+			n++
+		}
+	}
+	for _, s := range suite.Suites {
+		n += suiteFailures(s)
+	}
+	return n
+}
+
+// done returns true when the status is final.
+func done(s resultstore.Status) bool {
+	switch s {
+	case resultstorepb.Status_STATUS_UNSPECIFIED, resultstorepb.Status_BUILDING, resultstorepb.Status_TESTING:
+		return false
+	}
+	return true
+}
+
+// ignoreExists returns nil for AlreadyExists errors, which mean an earlier attempt created the resource.
+func ignoreExists(err error) error {
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// Publish idempotently uploads the build to ResultStore.
+//
+// The client must have a stable secret, so that later attempts may continue the upload.
+// Running builds only create the invocation. Once the build is done, publishing creates
+// any missing resources, updates the invocation with the final status and then finalizes it.
+// Publishing a finalized build does nothing.
+func Publish(client *resultstore.Client, up Upload) error {
+	name := "invocations/" + up.ID
+	log := logrus.WithField("invocation", name)
+	invs := client.Invocations()
+	existing, err := invs.Get(name, "name", "status_attributes")
+	switch {
+	case status.Code(err) == codes.NotFound:
+		running := up.Invocation
+		running.Status = resultstore.Running
+		running.Description = ""
+		if _, err := invs.CreateWithID(up.ID, running); ignoreExists(err) != nil {
+			return fmt.Errorf("create invocation: %w", err)
+		}
+		log.Debug("Created invocation")
+	case err != nil:
+		return fmt.Errorf("get invocation: %w", err)
+	case done(existing.Status):
+		// An earlier attempt uploaded everything, but may have failed to finalize.
+		if err := invs.Finish(name); err != nil && status.Code(err) != codes.FailedPrecondition {
+			return fmt.Errorf("finalize: %w", err)
+		}
+		log.Debug("Already published")
+		return nil
+	}
+
+	if !done(up.Invocation.Status) {
+		inv := up.Invocation
+		inv.Name = name
+		if err := invs.Update(inv, "timing", "properties"); err != nil {
+			return fmt.Errorf("update running invocation: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := client.Configurations(name).Create(resultstore.Default); ignoreExists(err) != nil {
+		return fmt.Errorf("create configuration: %w", err)
+	}
+	target := name + "/targets/" + up.TargetID
+	if _, err := client.Targets(name).Create(up.TargetID, up.Target); ignoreExists(err) != nil {
+		return fmt.Errorf("create target: %w", err)
+	}
+	configured := target + "/configuredTargets/" + resultstore.Default
+	ct := resultstore.Action{
+		Status:      up.Target.Status,
+		Description: up.Target.Description,
+	}
+	if _, err := client.ConfiguredTargets(target, resultstore.Default).Create(ct); ignoreExists(err) != nil {
+		return fmt.Errorf("create configured target: %w", err)
+	}
+	for _, test := range up.Tests {
+		if _, err := client.Actions(configured).Create(test.ID, test.Test); ignoreExists(err) != nil {
+			return fmt.Errorf("create %s action: %w", test.ID, err)
+		}
+	}
+
+	inv := up.Invocation
+	inv.Name = name
+	if err := invs.Update(inv, "timing", "status_attributes", "properties"); err != nil {
+		return fmt.Errorf("update invocation: %w", err)
+	}
+	if err := invs.Finish(name); err != nil {
+		return fmt.Errorf("finalize: %w", err)
+	}
+	log.WithField("tests", len(up.Tests)).Debug("Finalized invocation")
+	return nil
+}
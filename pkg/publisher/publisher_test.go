@@ -0,0 +1,400 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publisher
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore/fake"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func mustBuild(t *testing.T, s string) gcs.Build {
+	t.Helper()
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		t.Fatalf("NewPath(%q): %v", s, err)
+	}
+	return gcs.Build{Path: *p}
+}
+
+func TestRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "publisher")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"started.json":                `{"timestamp": 100}`,
+		"finished.json":               `{"timestamp": 200, "passed": true}`,
+		"artifacts/junit_b.xml":       `<testsuite><testcase name="b"/></testsuite>`,
+		"artifacts/junit_a.xml":       `<testsuite><testcase name="a"/></testsuite>`,
+		"artifacts/not-junit-log.txt": "hello",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, "job", "1", name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("MkdirAll: %v", err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+	}
+	u := url.URL{Scheme: "file", Path: filepath.Join(dir, "job", "1") + "/"}
+	build := mustBuild(t, u.String())
+
+	result, err := Read(context.Background(), gcs.NewLocalClient(), build)
+	if err != nil {
+		t.Fatalf("Read() got unexpected error: %v", err)
+	}
+	if result.Started.Timestamp != 100 {
+		t.Errorf("Read() got started %d, want 100", result.Started.Timestamp)
+	}
+	if result.Finished.Timestamp == nil || *result.Finished.Timestamp != 200 {
+		t.Errorf("Read() got finished %v, want 200", result.Finished.Timestamp)
+	}
+	var names []string
+	for _, s := range result.Suites {
+		names = append(names, s.Suites.Suites[0].Results[0].Name)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, names); diff != "" {
+		t.Errorf("Read() got unexpected suites (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	pint := func(v int64) *int64 {
+		return &v
+	}
+	pbool := func(v bool) *bool {
+		return &v
+	}
+	pstr := func(s string) *string {
+		return &s
+	}
+	const prefix = "gs://bucket/logs/ci-job/123/"
+	start := time.Unix(100, 0)
+	cases := []struct {
+		name     string
+		result   Result
+		expected Upload
+	}{
+		{
+			name: "running",
+			result: Result{
+				Started: gcs.Started{
+					Started: metadata.Started{Timestamp: 100},
+				},
+				Finished: gcs.Finished{Running: true},
+			},
+			expected: Upload{
+				Invocation: resultstore.Invocation{
+					Project: "project",
+					Details: prefix,
+					Start:   start,
+					Status:  resultstore.Running,
+					Files:   []resultstore.File{{ID: "build-log.txt", URL: prefix + "build-log.txt"}},
+				},
+				TargetID: "ci-job",
+				Target: resultstore.Target{
+					Start:  start,
+					Status: resultstore.Running,
+				},
+			},
+		},
+		{
+			name: "failing test",
+			result: Result{
+				Started: gcs.Started{
+					Started: metadata.Started{Timestamp: 100},
+				},
+				Finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(160),
+						Passed:    pbool(false),
+						Result:    "FAILURE",
+						Metadata: metadata.Metadata{
+							"version": "v1",
+							"ignored": []string{"non-string"},
+						},
+					},
+				},
+				Suites: []gcs.SuitesMeta{
+					{
+						Path: prefix + "artifacts/junit_01.xml",
+						Suites: junit.Suites{
+							Suites: []junit.Suite{
+								{
+									Name: "suite",
+									Time: 3,
+									Results: []junit.Result{
+										{Name: "good", ClassName: "class", Time: 1},
+										{Name: "bad", Time: 2, Failure: pstr("boom")},
+										{Name: "skip", Skipped: pstr("")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: Upload{
+				Invocation: resultstore.Invocation{
+					Project:     "project",
+					Details:     prefix,
+					Start:       start,
+					Duration:    time.Minute,
+					Status:      resultstore.Failed,
+					Description: "FAILURE",
+					Files:       []resultstore.File{{ID: "build-log.txt", URL: prefix + "build-log.txt"}},
+					Properties:  []resultstore.Property{{Key: "version", Value: "v1"}},
+				},
+				TargetID: "ci-job",
+				Target: resultstore.Target{
+					Start:       start,
+					Duration:    time.Minute,
+					Status:      resultstore.Failed,
+					Description: "FAILURE",
+				},
+				Tests: []Test{
+					{
+						ID: "artifacts_junit_01.xml",
+						Test: resultstore.Test{
+							Action: resultstore.Action{
+								Start:  start,
+								Status: resultstore.Failed,
+							},
+							Suite: resultstore.Suite{
+								Name:     "artifacts/junit_01.xml",
+								Duration: 3 * time.Second,
+								Files: []resultstore.File{
+									{ID: "junit.xml", ContentType: "text/xml", URL: prefix + "artifacts/junit_01.xml"},
+								},
+								Suites: []resultstore.Suite{
+									{
+										Name:     "suite",
+										Duration: 3 * time.Second,
+										Cases: []resultstore.Case{
+											{
+												Name:     "good",
+												Class:    "class",
+												Duration: time.Second,
+												Result:   resultstore.Completed,
+											},
+											{
+												Name:     "bad",
+												Duration: 2 * time.Second,
+												Result:   resultstore.Completed,
+												Failures: []resultstore.Failure{{Message: "boom"}},
+											},
+											{
+												Name:   "skip",
+												Result: resultstore.Skipped,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "failed outside of tests",
+			result: Result{
+				Started: gcs.Started{
+					Started: metadata.Started{Timestamp: 100},
+				},
+				Finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(160),
+						Passed:    pbool(false),
+					},
+				},
+			},
+			expected: Upload{
+				Invocation: resultstore.Invocation{
+					Project:  "project",
+					Details:  prefix,
+					Start:    start,
+					Duration: time.Minute,
+					Status:   resultstore.Failed,
+					Files:    []resultstore.File{{ID: "build-log.txt", URL: prefix + "build-log.txt"}},
+				},
+				TargetID: "ci-job",
+				Target: resultstore.Target{
+					Start:    start,
+					Duration: time.Minute,
+					Status:   resultstore.Failed,
+				},
+				Tests: []Test{
+					{
+						ID: "build",
+						Test: resultstore.Test{
+							Action: resultstore.Action{
+								Start:    start,
+								Duration: time.Minute,
+								Status:   resultstore.Failed,
+							},
+							Suite: resultstore.Suite{
+								Name:     "build",
+								Duration: time.Minute,
+								Errors:   []resultstore.Error{{Message: "Build failed outside of test results"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			build := mustBuild(t, prefix)
+			tc.expected.ID = InvocationID(build)
+			actual := Convert("project", build, tc.result)
+			if diff := cmp.Diff(tc.expected, actual, cmpopts.IgnoreUnexported(resultstorepb.Property{})); diff != "" {
+				t.Errorf("Convert() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInvocationID(t *testing.T) {
+	a := InvocationID(mustBuild(t, "gs://bucket/logs/job/1/"))
+	if again := InvocationID(mustBuild(t, "gs://bucket/logs/job/1/")); a != again {
+		t.Errorf("InvocationID() got %q then %q for the same build", a, again)
+	}
+	if b := InvocationID(mustBuild(t, "gs://bucket/logs/job/2/")); a == b {
+		t.Errorf("InvocationID() got %q for different builds", a)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := fake.NewServer()
+	conn, stop, err := srv.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() got unexpected error: %v", err)
+	}
+	defer stop()
+	client := resultstore.NewClient(conn).WithContext(ctx).WithSecret("secret")
+
+	pint := func(v int64) *int64 {
+		return &v
+	}
+	pbool := func(v bool) *bool {
+		return &v
+	}
+	build := mustBuild(t, "gs://bucket/logs/ci-job/123/")
+	running := Convert("project", build, Result{
+		Started:  gcs.Started{Started: metadata.Started{Timestamp: 100}},
+		Finished: gcs.Finished{Running: true},
+	})
+	finished := Convert("project", build, Result{
+		Started: gcs.Started{Started: metadata.Started{Timestamp: 100}},
+		Finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Timestamp: pint(200),
+				Passed:    pbool(true),
+			},
+		},
+		Suites: []gcs.SuitesMeta{
+			{
+				Path: "gs://bucket/logs/ci-job/123/artifacts/junit.xml",
+				Suites: junit.Suites{
+					Suites: []junit.Suite{{Results: []junit.Result{{Name: "hello"}}}},
+				},
+			},
+		},
+	})
+	name := "invocations/" + finished.ID
+
+	if err := Publish(client, running); err != nil {
+		t.Fatalf("Publish(running) got unexpected error: %v", err)
+	}
+	if srv.Finalized(name) {
+		t.Error("Publish(running) finalized the invocation")
+	}
+	// Publishing again continues the upload.
+	if err := Publish(client, running); err != nil {
+		t.Fatalf("Publish(running) again got unexpected error: %v", err)
+	}
+	// Simulate an attempt that failed after creating the target.
+	if _, err := client.Targets(name).Create(finished.TargetID, finished.Target); err != nil {
+		t.Fatalf("Create() target got unexpected error: %v", err)
+	}
+
+	if err := Publish(client, finished); err != nil {
+		t.Fatalf("Publish(finished) got unexpected error: %v", err)
+	}
+	if !srv.Finalized(name) {
+		t.Error("Publish(finished) failed to finalize the invocation")
+	}
+	if err := Publish(client, finished); err != nil {
+		t.Errorf("Publish(finished) again got unexpected error: %v", err)
+	}
+
+	inv, err := client.Invocations().Get(name)
+	if err != nil {
+		t.Fatalf("Get() got unexpected error: %v", err)
+	}
+	if inv.Status != resultstore.Passed || inv.Duration != 100*time.Second {
+		t.Errorf("Get() got status %s after %s, want %s after 100s", inv.Status, inv.Duration, resultstore.Passed)
+	}
+	tree, err := client.Tree(name, 1)
+	if err != nil {
+		t.Fatalf("Tree() got unexpected error: %v", err)
+	}
+	if n := len(tree.Targets); n != 1 {
+		t.Fatalf("Tree() got %d targets, want 1", n)
+	}
+	cts := tree.Targets[0].ConfiguredTargets
+	if len(cts) != 1 || len(cts[0].Tests) != 1 {
+		t.Fatalf("Tree() got configured targets %v, want one with one test", cts)
+	}
+	if got := cts[0].Tests[0].Suite.Suites[0].Cases[0].Name; got != "hello" {
+		t.Errorf("Tree() got case %q, want hello", got)
+	}
+
+	other := resultstore.NewClient(conn).WithContext(ctx).WithSecret("wrong")
+	unpublished := Convert("project", mustBuild(t, "gs://bucket/logs/ci-job/456/"), Result{
+		Started:  gcs.Started{Started: metadata.Started{Timestamp: 100}},
+		Finished: gcs.Finished{Running: true},
+	})
+	if err := Publish(client, unpublished); err != nil {
+		t.Fatalf("Publish(unpublished) got unexpected error: %v", err)
+	}
+	if err := Publish(other, unpublished); err == nil {
+		t.Error("Publish() with the wrong secret failed to return an error")
+	}
+}
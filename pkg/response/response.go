@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package response converts grid state into the response the TestGrid javascript client expects.
+package response
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	responsepb "github.com/GoogleCloudPlatform/testgrid/pb/response"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+const (
+	includeFilter = "include-filter-by-regex"
+	excludeFilter = "exclude-filter-by-regex"
+)
+
+// FromGrid converts the grid state of a dashboard tab into a response.
+//
+// Rows are filtered by the include/exclude regexes in the tab's base_options,
+// and split into tabular name groups when the tab sets tabular_names_regex.
+func FromGrid(dashboard string, tab *configpb.DashboardTab, grid *statepb.Grid) (*responsepb.Response, error) {
+	group := grid.GetConfig()
+	resp := responsepb.Response{
+		DashboardName:         dashboard,
+		TestGroupName:         tab.TestGroupName,
+		QueryParam:            group.GetGcsPrefix(),
+		Description:           tab.Description,
+		BugComponent:          tab.BugComponent,
+		CodeSearchPath:        tab.CodeSearchPath,
+		OpenTestTemplate:      tab.OpenTestTemplate,
+		FileBugTemplate:       tab.FileBugTemplate,
+		AttachBugTemplate:     tab.AttachBugTemplate,
+		ResultsUrlTemplate:    tab.ResultsUrlTemplate,
+		CodeSearchUrlTemplate: tab.CodeSearchUrlTemplate,
+		OpenBugTemplate:       tab.OpenBugTemplate,
+		AboutDashboardUrl:     tab.AboutDashboardUrl,
+		ResultsText:           tab.ResultsText,
+		Notifications:         group.GetNotifications(),
+		TestGroup:             group,
+		DashboardTab:          tab,
+		UpdateTimestamp:       int64(grid.LastTimeUpdated),
+	}
+
+	for _, h := range group.GetColumnHeader() {
+		resp.ColumnHeaderNames = append(resp.ColumnHeaderNames, headerName(h))
+	}
+
+	for _, col := range grid.Columns {
+		resp.BuildIds = append(resp.BuildIds, col.Build)
+		resp.ColumnIds = append(resp.ColumnIds, col.Name)
+		resp.Timestamps = append(resp.Timestamps, int64(col.Started))
+		resp.CustomColumns = append(resp.CustomColumns, &responsepb.Response_CustomColumns{
+			CustomColumns: col.Extra,
+		})
+	}
+
+	rows, err := filterRows(tab.BaseOptions, grid.Rows)
+	if err != nil {
+		return nil, fmt.Errorf("filter: %w", err)
+	}
+
+	var tabular *regexp.Regexp
+	if tab.TabularNamesRegex != "" {
+		tabular, err = regexp.Compile(tab.TabularNamesRegex)
+		if err != nil {
+			return nil, fmt.Errorf("bad tabular_names_regex: %w", err)
+		}
+		resp.AddTabularNamesOption = true
+		for _, name := range tabular.SubexpNames()[1:] {
+			resp.TabularNamesColumnHeaders = append(resp.TabularNamesColumnHeaders, name)
+		}
+	}
+
+	metrics := map[string]bool{}
+	for _, row := range rows {
+		r := convertRow(row, grid.Columns)
+		if tabular != nil {
+			r.TabularNameGroups = tabularGroups(tabular, row.Name)
+		}
+		for _, m := range row.Metrics {
+			metrics[m.Name] = true
+		}
+		resp.Tests = append(resp.Tests, r)
+		resp.RowIds = append(resp.RowIds, row.Id)
+	}
+	for name := range metrics {
+		resp.Metrics = append(resp.Metrics, name)
+	}
+	sort.Strings(resp.Metrics)
+
+	for _, md := range grid.TestMetadata {
+		if resp.TestMetadata == nil {
+			resp.TestMetadata = map[string]*responsepb.TestMetadata{}
+		}
+		resp.TestMetadata[md.TestName] = &responsepb.TestMetadata{
+			BugComponent: md.BugComponent,
+			Owner:        md.Owner,
+			Cc:           md.Cc,
+		}
+	}
+	return &resp, nil
+}
+
+// headerName returns the name to display for a column header.
+func headerName(h *configpb.TestGroup_ColumnHeader) string {
+	switch {
+	case h.Label != "":
+		return h.Label
+	case h.ConfigurationValue != "":
+		return h.ConfigurationValue
+	}
+	return h.Property
+}
+
+// filterRows returns the rows matching the include and exclude regexes in the base options.
+func filterRows(baseOptions string, rows []*statepb.Row) ([]*statepb.Row, error) {
+	vals, err := url.ParseQuery(baseOptions)
+	if err != nil {
+		return nil, fmt.Errorf("parse %q: %w", baseOptions, err)
+	}
+	var includes, excludes []*regexp.Regexp
+	for _, include := range vals[includeFilter] {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s=%s: %w", includeFilter, include, err)
+		}
+		includes = append(includes, re)
+	}
+	for _, exclude := range vals[excludeFilter] {
+		re, err := regexp.Compile(exclude)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s=%s: %w", excludeFilter, exclude, err)
+		}
+		excludes = append(excludes, re)
+	}
+
+	var out []*statepb.Row
+	for _, row := range rows {
+		if matchRow(row.Name, includes, excludes) {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+// matchRow returns true if the name matches every include regex and no exclude regex.
+func matchRow(name string, includes, excludes []*regexp.Regexp) bool {
+	for _, re := range includes {
+		if !re.MatchString(name) {
+			return false
+		}
+	}
+	for _, re := range excludes {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// tabularGroups returns the value of each named group in the regex, or empty strings when the name does not match.
+func tabularGroups(re *regexp.Regexp, name string) []string {
+	groups := make([]string, len(re.SubexpNames())-1)
+	if mat := re.FindStringSubmatch(name); mat != nil {
+		copy(groups, mat[1:])
+	}
+	return groups
+}
+
+// convertRow converts a grid row into a response row.
+//
+// Messages and short texts remain sparse, skipping cells without a result.
+func convertRow(row *statepb.Row, columns []*statepb.Column) *responsepb.Row {
+	r := responsepb.Row{
+		Name:         row.Name,
+		OriginalName: row.Id,
+		Messages:     row.Messages,
+		ShortTexts:   row.Icons,
+		TestIds:      row.CellIds,
+		LinkedBugs:   row.BugId,
+		Alert:        convertAlert(row.Name, row.AlertInfo),
+		UserProperty: row.UserProperty,
+	}
+	for i := 0; i+1 < len(row.Results); i += 2 {
+		r.Statuses = append(r.Statuses, &responsepb.RleStatus{
+			Value: row.Results[i],
+			Count: row.Results[i+1],
+		})
+	}
+	for _, m := range row.Metrics {
+		r.MetricInfo = append(r.MetricInfo, &responsepb.RawMetric{
+			Id:     m.Name,
+			Layout: m.Indices,
+			Value:  m.Values,
+		})
+		r.Graphs = append(r.Graphs, graph(row.Name, m, columns))
+	}
+	return &r
+}
+
+// graph converts a sparse metric into a graph, labeling each value with its build.
+func graph(name string, metric *statepb.Metric, columns []*statepb.Column) *responsepb.Graph {
+	g := responsepb.Graph{
+		Metric: metric.Name,
+		Names:  []string{name},
+	}
+	var vals responsepb.Graph_Values
+	var v int
+	for i := 0; i+1 < len(metric.Indices); i += 2 {
+		start, count := int(metric.Indices[i]), int(metric.Indices[i+1])
+		for col := start; col < start+count && v < len(metric.Values); col++ {
+			var label string
+			if col < len(columns) {
+				label = columns[col].Build
+			}
+			g.Labels = append(g.Labels, label)
+			vals.Values = append(vals.Values, metric.Values[v])
+			v++
+		}
+	}
+	g.Values = []*responsepb.Graph_Values{&vals}
+	return &g
+}
+
+// convertAlert converts alert info into the test alert the client displays.
+func convertAlert(name string, info *statepb.AlertInfo) *responsepb.TestAlert {
+	if info == nil {
+		return nil
+	}
+	alert := responsepb.TestAlert{
+		FailBuildId: info.FailBuildId,
+		FailCount:   int64(info.FailCount),
+		Message:     info.FailureMessage,
+		LinkText:    info.BuildLinkText,
+		Link:        info.BuildLink,
+		UrlText:     info.BuildUrlText,
+		TestId:      info.FailTestId,
+		PassBuildId: info.PassBuildId,
+		TestName:    name,
+		FailTime:    int32(info.FailTime.GetSeconds()),
+		PassTime:    int32(info.PassTime.GetSeconds()),
+	}
+	alert.Text = fmt.Sprintf("%s has failed %d times since %s", name, info.FailCount, info.FailBuildId)
+	return &alert
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package response
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/go-cmp/cmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	responsepb "github.com/GoogleCloudPlatform/testgrid/pb/response"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func TestFromGrid(t *testing.T) {
+	group := &configpb.TestGroup{
+		Name:      "group",
+		GcsPrefix: "bucket/job",
+		ColumnHeader: []*configpb.TestGroup_ColumnHeader{
+			{ConfigurationValue: "Commit"},
+			{Label: "os", Property: "node_os"},
+		},
+	}
+	columns := []*statepb.Column{
+		{Build: "2", Name: "2", Started: 2000, Extra: []string{"abc", "linux"}},
+		{Build: "1", Name: "1", Started: 1000, Extra: []string{"def", "linux"}},
+	}
+	cases := []struct {
+		name     string
+		tab      *configpb.DashboardTab
+		grid     *statepb.Grid
+		expected *responsepb.Response
+		err      bool
+	}{
+		{
+			name: "basically works",
+			tab: &configpb.DashboardTab{
+				Name:          "tab",
+				TestGroupName: "group",
+				Description:   "hello",
+			},
+			grid: &statepb.Grid{
+				Config:          group,
+				Columns:         columns,
+				LastTimeUpdated: 3000,
+				Rows: []*statepb.Row{
+					{
+						Name:         "Overall",
+						Id:           "Overall",
+						Results:      []int32{int32(statuspb.TestStatus_PASS), 1, int32(statuspb.TestStatus_FAIL), 1},
+						CellIds:      []string{"", ""},
+						Messages:     []string{"", "boom"},
+						Icons:        []string{"", "F"},
+						UserProperty: []string{"node-1", "node-2"},
+					},
+				},
+			},
+			expected: &responsepb.Response{
+				DashboardName:     "dash",
+				TestGroupName:     "group",
+				QueryParam:        "bucket/job",
+				Description:       "hello",
+				ColumnHeaderNames: []string{"Commit", "os"},
+				BuildIds:          []string{"2", "1"},
+				ColumnIds:         []string{"2", "1"},
+				Timestamps:        []int64{2000, 1000},
+				CustomColumns: []*responsepb.Response_CustomColumns{
+					{CustomColumns: []string{"abc", "linux"}},
+					{CustomColumns: []string{"def", "linux"}},
+				},
+				Tests: []*responsepb.Row{
+					{
+						Name:         "Overall",
+						OriginalName: "Overall",
+						Statuses: []*responsepb.RleStatus{
+							{Value: int32(statuspb.TestStatus_PASS), Count: 1},
+							{Value: int32(statuspb.TestStatus_FAIL), Count: 1},
+						},
+						TestIds:      []string{"", ""},
+						Messages:     []string{"", "boom"},
+						ShortTexts:   []string{"", "F"},
+						UserProperty: []string{"node-1", "node-2"},
+					},
+				},
+				RowIds:          []string{"Overall"},
+				UpdateTimestamp: 3000,
+			},
+		},
+		{
+			name: "filter rows and split tabular names",
+			tab: &configpb.DashboardTab{
+				Name:              "tab",
+				TestGroupName:     "group",
+				BaseOptions:       "include-filter-by-regex=^//&exclude-filter-by-regex=flaky",
+				TabularNamesRegex: `^//(?P<dir>[^:]+):(?P<target>.+)$`,
+			},
+			grid: &statepb.Grid{
+				Config:  group,
+				Columns: columns,
+				Rows: []*statepb.Row{
+					{Name: "Overall", Id: "Overall", Results: []int32{1, 2}},
+					{Name: "//foo:bar", Id: "//foo:bar", Results: []int32{1, 2}},
+					{Name: "//foo:flaky", Id: "//foo:flaky", Results: []int32{1, 2}},
+				},
+			},
+			expected: &responsepb.Response{
+				DashboardName:             "dash",
+				TestGroupName:             "group",
+				QueryParam:                "bucket/job",
+				ColumnHeaderNames:         []string{"Commit", "os"},
+				BuildIds:                  []string{"2", "1"},
+				ColumnIds:                 []string{"2", "1"},
+				Timestamps:                []int64{2000, 1000},
+				AddTabularNamesOption:     true,
+				TabularNamesColumnHeaders: []string{"dir", "target"},
+				CustomColumns: []*responsepb.Response_CustomColumns{
+					{CustomColumns: []string{"abc", "linux"}},
+					{CustomColumns: []string{"def", "linux"}},
+				},
+				Tests: []*responsepb.Row{
+					{
+						Name:              "//foo:bar",
+						OriginalName:      "//foo:bar",
+						Statuses:          []*responsepb.RleStatus{{Value: 1, Count: 2}},
+						TabularNameGroups: []string{"foo", "bar"},
+					},
+				},
+				RowIds: []string{"//foo:bar"},
+			},
+		},
+		{
+			name: "metrics become graphs",
+			tab: &configpb.DashboardTab{
+				Name:          "tab",
+				TestGroupName: "group",
+			},
+			grid: &statepb.Grid{
+				Config:  group,
+				Columns: columns,
+				Rows: []*statepb.Row{
+					{
+						Name:    "test",
+						Id:      "test",
+						Results: []int32{1, 2},
+						Metrics: []*statepb.Metric{
+							{Name: "elapsed", Indices: []int32{0, 2}, Values: []float64{1.5, 2.5}},
+							{Name: "cpu", Indices: []int32{1, 1}, Values: []float64{3}},
+						},
+					},
+				},
+			},
+			expected: &responsepb.Response{
+				DashboardName:     "dash",
+				TestGroupName:     "group",
+				QueryParam:        "bucket/job",
+				ColumnHeaderNames: []string{"Commit", "os"},
+				BuildIds:          []string{"2", "1"},
+				ColumnIds:         []string{"2", "1"},
+				Timestamps:        []int64{2000, 1000},
+				CustomColumns: []*responsepb.Response_CustomColumns{
+					{CustomColumns: []string{"abc", "linux"}},
+					{CustomColumns: []string{"def", "linux"}},
+				},
+				Tests: []*responsepb.Row{
+					{
+						Name:         "test",
+						OriginalName: "test",
+						Statuses:     []*responsepb.RleStatus{{Value: 1, Count: 2}},
+						MetricInfo: []*responsepb.RawMetric{
+							{Id: "elapsed", Layout: []int32{0, 2}, Value: []float64{1.5, 2.5}},
+							{Id: "cpu", Layout: []int32{1, 1}, Value: []float64{3}},
+						},
+						Graphs: []*responsepb.Graph{
+							{
+								Metric: "elapsed",
+								Names:  []string{"test"},
+								Labels: []string{"2", "1"},
+								Values: []*responsepb.Graph_Values{{Values: []float64{1.5, 2.5}}},
+							},
+							{
+								Metric: "cpu",
+								Names:  []string{"test"},
+								Labels: []string{"1"},
+								Values: []*responsepb.Graph_Values{{Values: []float64{3}}},
+							},
+						},
+					},
+				},
+				RowIds:  []string{"test"},
+				Metrics: []string{"cpu", "elapsed"},
+			},
+		},
+		{
+			name: "bad regex errors",
+			tab: &configpb.DashboardTab{
+				Name:              "tab",
+				TestGroupName:     "group",
+				TabularNamesRegex: "(",
+			},
+			grid: &statepb.Grid{Config: group},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := FromGrid("dash", tc.tab, tc.grid)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("FromGrid() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatalf("FromGrid() failed to return an error")
+			}
+			tc.expected.TestGroup = tc.grid.Config
+			tc.expected.DashboardTab = tc.tab
+			if diff := cmp.Diff(actual, tc.expected, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("FromGrid() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterRows(t *testing.T) {
+	rows := []*statepb.Row{
+		{Name: "foo-linux"},
+		{Name: "foo-windows"},
+		{Name: "bar-linux"},
+	}
+	cases := []struct {
+		name        string
+		baseOptions string
+		expected    []string
+		err         bool
+	}{
+		{
+			name:     "no options returns everything",
+			expected: []string{"foo-linux", "foo-windows", "bar-linux"},
+		},
+		{
+			name:        "include",
+			baseOptions: "include-filter-by-regex=foo",
+			expected:    []string{"foo-linux", "foo-windows"},
+		},
+		{
+			name:        "exclude",
+			baseOptions: "exclude-filter-by-regex=windows",
+			expected:    []string{"foo-linux", "bar-linux"},
+		},
+		{
+			name:        "rows must match every include",
+			baseOptions: "include-filter-by-regex=foo&include-filter-by-regex=linux",
+			expected:    []string{"foo-linux"},
+		},
+		{
+			name:        "bad regex",
+			baseOptions: "include-filter-by-regex=(",
+			err:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := filterRows(tc.baseOptions, rows)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("filterRows() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatalf("filterRows() failed to return an error")
+			}
+			var actual []string
+			for _, row := range out {
+				actual = append(actual, row.Name)
+			}
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("filterRows() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTabularGroups(t *testing.T) {
+	re := regexp.MustCompile(`^(?P<a>\w+)-(?P<b>\w+)$`)
+	cases := []struct {
+		name     string
+		row      string
+		expected []string
+	}{
+		{
+			name:     "basically works",
+			row:      "foo-bar",
+			expected: []string{"foo", "bar"},
+		},
+		{
+			name:     "mismatches are empty",
+			row:      "foo",
+			expected: []string{"", ""},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tabularGroups(re, tc.row), tc.expected); diff != "" {
+				t.Errorf("tabularGroups() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertAlert(t *testing.T) {
+	cases := []struct {
+		name     string
+		info     *statepb.AlertInfo
+		expected *responsepb.TestAlert
+	}{
+		{
+			name: "nil is nil",
+		},
+		{
+			name: "basically works",
+			info: &statepb.AlertInfo{
+				FailCount:   3,
+				FailBuildId: "5",
+				PassBuildId: "4",
+				FailTime:    &timestamp.Timestamp{Seconds: 500},
+				PassTime:    &timestamp.Timestamp{Seconds: 400},
+				FailTestId:  "id",
+			},
+			expected: &responsepb.TestAlert{
+				FailBuildId: "5",
+				FailCount:   3,
+				FailTime:    500,
+				PassBuildId: "4",
+				PassTime:    400,
+				TestId:      "id",
+				TestName:    "test",
+				Text:        "test has failed 3 times since 5",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := convertAlert("test", tc.info)
+			if diff := cmp.Diff(actual, tc.expected, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("convertAlert() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package response
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	responsepb "github.com/GoogleCloudPlatform/testgrid/pb/response"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/store"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Prefix is the path under which the server responds to requests.
+const Prefix = "/api/"
+
+// Server serves /api/<dashboard>/<tab> requests with the response for that tab.
+type Server struct {
+	store      *store.Reader
+	gridPrefix string
+}
+
+// NewServer returns a server reading the configuration at configPath and the
+// grid state of each test group under gridPrefix, relative to the configuration.
+func NewServer(client gcs.Opener, configPath gcs.Path, gridPrefix string) *Server {
+	return &Server{
+		store:      store.NewReader(client, configPath),
+		gridPrefix: gridPrefix,
+	}
+}
+
+// splitPath returns the dashboard and tab of an /api/<dashboard>/<tab> path.
+func splitPath(p string) (string, string, bool) {
+	if !strings.HasPrefix(p, Prefix) {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(p, Prefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// ServeHTTP writes the JSON response for the requested dashboard tab.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	dashboard, tabName, ok := splitPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	log := logrus.WithFields(logrus.Fields{"dashboard": dashboard, "tab": tabName})
+	ctx := r.Context()
+	cfg, _, err := s.store.Config(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to read config")
+		http.Error(w, "failed to read config", http.StatusInternalServerError)
+		return
+	}
+	tab := config.FindDashboardTab(dashboard, tabName, cfg)
+	if tab == nil {
+		http.NotFound(w, r)
+		return
+	}
+	grid, _, err := s.store.Grid(ctx, path.Join(s.gridPrefix, tab.TestGroupName))
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		http.Error(w, "no state for "+tab.TestGroupName, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to read grid")
+		http.Error(w, "failed to read grid", http.StatusInternalServerError)
+		return
+	}
+	if grid.Config == nil {
+		grid.Config = config.FindTestGroup(tab.TestGroupName, cfg)
+	}
+	resp, err := FromGrid(dashboard, tab, grid)
+	if err != nil {
+		log.WithError(err).Error("Failed to convert grid")
+		http.Error(w, "failed to convert grid", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := WriteJSON(w, resp); err != nil {
+		log.WithError(err).Error("Failed to write response")
+	}
+}
+
+// WriteJSON writes the response in the JSON format the javascript client expects.
+//
+// Fields the client does not read (the test group, dashboard tab and raw row metrics) are omitted.
+func WriteJSON(w io.Writer, resp *responsepb.Response) error {
+	out := proto.Clone(resp).(*responsepb.Response)
+	out.TestGroup = nil
+	out.DashboardTab = nil
+	for _, row := range out.Tests {
+		row.MetricInfo = nil
+	}
+	var m jsonpb.Marshaler
+	return m.Marshal(w, out)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package response
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func writeFile(t *testing.T, name string, buf []byte) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatalf("Failed to create %s: %v", filepath.Dir(name), err)
+	}
+	if err := ioutil.WriteFile(name, buf, 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	dir, err := ioutil.TempDir("", "response")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{Name: "group", GcsPrefix: "bucket/job", DaysOfResults: 1, NumColumnsRecent: 1},
+			{Name: "missing", GcsPrefix: "bucket/missing", DaysOfResults: 1, NumColumnsRecent: 1},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{Name: "tab", TestGroupName: "group"},
+					{Name: "empty", TestGroupName: "missing"},
+				},
+			},
+		},
+	}
+	cfgBuf, err := config.MarshalBytes(cfg)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+	writeFile(t, filepath.Join(dir, "config"), cfgBuf)
+
+	grid := &statepb.Grid{
+		Columns: []*statepb.Column{{Build: "1", Name: "1", Started: 1000}},
+		Rows: []*statepb.Row{
+			{
+				Name:    "test",
+				Id:      "test",
+				Results: []int32{1, 1},
+				Metrics: []*statepb.Metric{{Name: "elapsed", Indices: []int32{0, 1}, Values: []float64{2}}},
+			},
+		},
+	}
+	gridBuf, err := proto.Marshal(grid)
+	if err != nil {
+		t.Fatalf("Failed to marshal grid: %v", err)
+	}
+	var zbuf bytes.Buffer
+	zw := zlib.NewWriter(&zbuf)
+	zw.Write(gridBuf)
+	zw.Close()
+	writeFile(t, filepath.Join(dir, "grid", "group"), zbuf.Bytes())
+
+	var configPath gcs.Path
+	if err := configPath.Set("file://" + filepath.ToSlash(dir) + "/config"); err != nil {
+		t.Fatalf("Failed to create config path: %v", err)
+	}
+	server := NewServer(gcs.NewLocalClient(), configPath, "grid")
+
+	cases := []struct {
+		name     string
+		method   string
+		path     string
+		code     int
+		expected map[string]interface{}
+	}{
+		{
+			name: "basically works",
+			path: "/api/dash/tab",
+			code: http.StatusOK,
+			expected: map[string]interface{}{
+				"dashboardName":   "dash",
+				"test-group-name": "group",
+				"query":           "bucket/job",
+				"build-ids":       []interface{}{"1"},
+				"columnIds":       []interface{}{"1"},
+				"timestamps":      []interface{}{"1000"},
+				"custom-columns":  []interface{}{map[string]interface{}{}},
+				"rowIds":          []interface{}{"test"},
+				"metrics":         []interface{}{"elapsed"},
+				"tests": []interface{}{
+					map[string]interface{}{
+						"name":          "test",
+						"original-name": "test",
+						"statuses":      []interface{}{map[string]interface{}{"value": float64(1), "count": float64(1)}},
+						"graphs": []interface{}{
+							map[string]interface{}{
+								"metric": "elapsed",
+								"names":  []interface{}{"test"},
+								"labels": []interface{}{"1"},
+								"values": []interface{}{map[string]interface{}{"values": []interface{}{float64(2)}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown dashboard",
+			path: "/api/missing/tab",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "unknown tab",
+			path: "/api/dash/missing",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "missing grid",
+			path: "/api/dash/empty",
+			code: http.StatusNotFound,
+		},
+		{
+			name: "bad path",
+			path: "/api/dash",
+			code: http.StatusNotFound,
+		},
+		{
+			name:   "reject post",
+			method: http.MethodPost,
+			path:   "/api/dash/tab",
+			code:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(method, tc.path, nil))
+			if rec.Code != tc.code {
+				t.Fatalf("ServeHTTP() got code %d, want %d: %s", rec.Code, tc.code, rec.Body.String())
+			}
+			if tc.expected == nil {
+				return
+			}
+			var actual map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &actual); err != nil {
+				t.Fatalf("Failed to parse %q: %v", rec.Body.String(), err)
+			}
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("ServeHTTP() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	cases := []struct {
+		path      string
+		dashboard string
+		tab       string
+		ok        bool
+	}{
+		{path: "/api/dash/tab", dashboard: "dash", tab: "tab", ok: true},
+		{path: "/api/dash/"},
+		{path: "/api//tab"},
+		{path: "/api/dash/tab/more"},
+		{path: "/other/dash/tab"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			dashboard, tab, ok := splitPath(tc.path)
+			if dashboard != tc.dashboard || tab != tc.tab || ok != tc.ok {
+				t.Errorf("splitPath(%q) got %q, %q, %t, want %q, %q, %t", tc.path, dashboard, tab, ok, tc.dashboard, tc.tab, tc.ok)
+			}
+		})
+	}
+}
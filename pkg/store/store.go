@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package store reads the configuration and the objects stored relative to it,
+// such as grid state and dashboard summaries.
+package store
+
+import (
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Reader reads a configuration, caching it until its generation changes.
+type Reader struct {
+	client     gcs.Opener
+	configPath gcs.Path
+
+	lock       sync.Mutex
+	config     *configpb.Configuration
+	generation int64
+}
+
+// NewReader returns a reader for the configuration at configPath.
+//
+// The configuration is cached when the client is also a gcs.Stater,
+// and reread for every request otherwise.
+func NewReader(client gcs.Opener, configPath gcs.Path) *Reader {
+	return &Reader{
+		client:     client,
+		configPath: configPath,
+	}
+}
+
+// stat returns the generation of the object, or false when the client cannot stat objects.
+func (r *Reader) stat(ctx context.Context, p gcs.Path) (int64, bool, error) {
+	st, ok := r.client.(gcs.Stater)
+	if !ok {
+		return 0, false, nil
+	}
+	attrs, err := st.Stat(ctx, p)
+	if err != nil {
+		return 0, false, fmt.Errorf("stat %s: %w", p, err)
+	}
+	return attrs.Generation, true, nil
+}
+
+// Config returns the configuration and its generation, only rereading it when the generation changes.
+func (r *Reader) Config(ctx context.Context) (*configpb.Configuration, int64, error) {
+	gen, ok, err := r.stat(ctx, r.configPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if ok && r.config != nil && gen == r.generation {
+		return r.config, gen, nil
+	}
+	rc, gen, err := r.open(ctx, r.configPath, gen)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rc.Close()
+	cfg, err := config.Unmarshal(rc)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read %s: %w", r.configPath, err)
+	}
+	r.config = cfg
+	r.generation = gen
+	return cfg, gen, nil
+}
+
+// open opens the object, returning the generation of the opened object when the
+// client describes it and gen otherwise.
+func (r *Reader) open(ctx context.Context, p gcs.Path, gen int64) (io.ReadCloser, int64, error) {
+	rc, err := r.client.Open(ctx, p)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open %s: %w", p, err)
+	}
+	if attrs := gcs.ReaderAttrs(rc); attrs != nil {
+		gen = attrs.Generation
+	}
+	return rc, gen, nil
+}
+
+// Resolve returns the path of the named object, relative to the configuration.
+func (r *Reader) Resolve(name string) (*gcs.Path, error) {
+	p, err := r.configPath.ResolveReference(&url.URL{Path: name})
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", name, err)
+	}
+	return p, nil
+}
+
+// Generation returns the current generation of the named object,
+// or false when the client cannot stat objects.
+//
+// Allows checking whether an object changed without reading it.
+func (r *Reader) Generation(ctx context.Context, name string) (int64, bool, error) {
+	p, err := r.Resolve(name)
+	if err != nil {
+		return 0, false, err
+	}
+	return r.stat(ctx, *p)
+}
+
+// Read returns the contents of the named object and its generation.
+func (r *Reader) Read(ctx context.Context, name string) ([]byte, int64, error) {
+	p, err := r.Resolve(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	rc, gen, err := r.open(ctx, *p, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rc.Close()
+	buf, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read %s: %w", p, err)
+	}
+	return buf, gen, nil
+}
+
+// Grid returns the grid state in the named object and its generation.
+func (r *Reader) Grid(ctx context.Context, name string) (*statepb.Grid, int64, error) {
+	p, err := r.Resolve(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	rc, gen, err := r.open(ctx, *p, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rc.Close()
+	zr, err := zlib.NewReader(rc)
+	if err != nil {
+		return nil, 0, fmt.Errorf("decompress %s: %w", p, err)
+	}
+	buf, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read %s: %w", p, err)
+	}
+	var grid statepb.Grid
+	if err := proto.Unmarshal(buf, &grid); err != nil {
+		return nil, 0, fmt.Errorf("parse %s: %w", p, err)
+	}
+	return &grid, gen, nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// testDir writes a config and the named files into a temp dir, returning the config path.
+func testDir(t *testing.T, files map[string][]byte) (gcs.Path, func()) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{Name: "group", GcsPrefix: "bucket/job", DaysOfResults: 1, NumColumnsRecent: 1},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name:         "dash",
+				DashboardTab: []*configpb.DashboardTab{{Name: "tab", TestGroupName: "group"}},
+			},
+		},
+	}
+	buf, err := config.MarshalBytes(cfg)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+	files["config"] = buf
+	for name, buf := range files {
+		loc := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(loc), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(loc), err)
+		}
+		if err := ioutil.WriteFile(loc, buf, 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", loc, err)
+		}
+	}
+	var configPath gcs.Path
+	if err := configPath.Set("file://" + filepath.ToSlash(dir) + "/config"); err != nil {
+		t.Fatalf("Failed to create config path: %v", err)
+	}
+	return configPath, func() { os.RemoveAll(dir) }
+}
+
+// opener hides the Stat method of a client.
+type opener struct {
+	gcs.Opener
+}
+
+func TestConfig(t *testing.T) {
+	configPath, cleanup := testDir(t, map[string][]byte{})
+	defer cleanup()
+	ctx := context.Background()
+
+	reader := NewReader(gcs.NewLocalClient(), configPath)
+	cfg, gen, err := reader.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config() got unexpected error: %v", err)
+	}
+	if gen == 0 {
+		t.Errorf("Config() got zero generation")
+	}
+	if again, _, _ := reader.Config(ctx); again != cfg {
+		t.Errorf("Config() reread an unchanged config")
+	}
+
+	// Touching the config changes its generation.
+	later := time.Unix(0, gen).Add(time.Second)
+	name := strings.TrimPrefix(configPath.String(), "file://")
+	if err := os.Chtimes(name, later, later); err != nil {
+		t.Fatalf("Failed to touch config: %v", err)
+	}
+	again, againGen, err := reader.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config() got unexpected error: %v", err)
+	}
+	if again == cfg || againGen == gen {
+		t.Errorf("Config() failed to reread a changed config")
+	}
+
+	// Without Stat the config is reread every time.
+	reader = NewReader(opener{gcs.NewLocalClient()}, configPath)
+	cfg, _, err = reader.Config(ctx)
+	if err != nil {
+		t.Fatalf("Config() without Stat got unexpected error: %v", err)
+	}
+	if again, _, _ := reader.Config(ctx); again == cfg {
+		t.Errorf("Config() without Stat reused a config it cannot check")
+	}
+}
+
+func TestGrid(t *testing.T) {
+	grid := &statepb.Grid{
+		Columns: []*statepb.Column{{Build: "1", Name: "1", Started: 1000}},
+		Rows:    []*statepb.Row{{Name: "test", Id: "test", Results: []int32{1, 1}}},
+	}
+	buf, err := proto.Marshal(grid)
+	if err != nil {
+		t.Fatalf("Failed to marshal grid: %v", err)
+	}
+	var zbuf bytes.Buffer
+	zw := zlib.NewWriter(&zbuf)
+	zw.Write(buf)
+	zw.Close()
+	configPath, cleanup := testDir(t, map[string][]byte{"grid/group": zbuf.Bytes()})
+	defer cleanup()
+	ctx := context.Background()
+	reader := NewReader(gcs.NewLocalClient(), configPath)
+
+	actual, gen, err := reader.Grid(ctx, "grid/group")
+	if err != nil {
+		t.Fatalf("Grid() got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(actual, grid, cmp.Comparer(proto.Equal)); diff != "" {
+		t.Errorf("Grid() got unexpected diff (-have, +want):\n%s", diff)
+	}
+	current, ok, err := reader.Generation(ctx, "grid/group")
+	if err != nil || !ok {
+		t.Fatalf("Generation() got %t, %v", ok, err)
+	}
+	if gen == 0 || current != gen {
+		t.Errorf("Grid() got generation %d, Generation() got %d", gen, current)
+	}
+
+	if _, _, err := reader.Grid(ctx, "grid/missing"); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Grid() missing object got %v, want %v", err, storage.ErrObjectNotExist)
+	}
+}
@@ -55,11 +55,11 @@ type groupFinder func(string) (*configpb.TestGroup, gridReader, error)
 // Will use concurrency go routines to update dashboards in parallel.
 // Setting dashboard will limit update to this dashboard.
 // Will write summary proto when confirm is set.
-func Update(ctx context.Context, client *storage.Client, configPath gcs.Path, concurrency int, dashboard, gridPathPrefix, summaryPathPrefix string, confirm bool) error {
+func Update(ctx context.Context, client gcs.Client, configPath gcs.Path, concurrency int, dashboard, gridPathPrefix, summaryPathPrefix string, confirm bool) error {
 	if concurrency < 1 {
 		return fmt.Errorf("concurrency must be positive, got: %d", concurrency)
 	}
-	cfg, err := config.ReadGCS(ctx, gcs.NewClient(client), configPath)
+	cfg, err := config.ReadGCS(ctx, client, configPath)
 	if err != nil {
 		return fmt.Errorf("Failed to read config: %w", err)
 	}
@@ -101,7 +101,7 @@ func Update(ctx context.Context, client *storage.Client, configPath gcs.Path, co
 				if !confirm {
 					continue
 				}
-				summaryPath, err := configPath.ResolveReference(&url.URL{Path: path.Join(summaryPathPrefix, summaryPath(dash.Name))})
+				summaryPath, err := configPath.ResolveReference(&url.URL{Path: path.Join(summaryPathPrefix, SummaryPath(dash.Name))})
 				if err != nil {
 					log.WithError(err).Error("Cannot resolve summary path")
 					errCh <- errors.New(dash.Name)
@@ -151,26 +151,33 @@ var (
 	normalizer = regexp.MustCompile(`[^a-z0-9]+`)
 )
 
-func summaryPath(name string) string {
+// SummaryPath returns the name of the summary object for the named dashboard.
+func SummaryPath(name string) string {
 	// ''.join(c for c in n.lower() if c is alphanumeric
 	return "summary-" + normalizer.ReplaceAllString(strings.ToLower(name), "")
 }
 
-func writeSummary(ctx context.Context, client *storage.Client, path gcs.Path, sum *summarypb.DashboardSummary) error {
+func writeSummary(ctx context.Context, client gcs.Uploader, path gcs.Path, sum *summarypb.DashboardSummary) error {
 	buf, err := proto.Marshal(sum)
 	if err != nil {
 		return fmt.Errorf("marshal: %v", err)
 	}
-	return gcs.Upload(ctx, client, path, buf, gcs.DefaultAcl, "no-cache") // TODO(fejta): configurable cache value
+	return client.Upload(ctx, path, buf, gcs.DefaultAcl, "no-cache") // TODO(fejta): configurable cache value
 }
 
 // pathReader returns a reader for the specified path and last modified, generation metadata.
-func pathReader(ctx context.Context, client *storage.Client, path gcs.Path) (io.ReadCloser, time.Time, int64, error) {
-	r, err := client.Bucket(path.Bucket()).Object(path.Object()).NewReader(ctx)
+//
+// The metadata is zero when the client does not describe the objects it opens.
+func pathReader(ctx context.Context, client gcs.Opener, path gcs.Path) (io.ReadCloser, time.Time, int64, error) {
+	r, err := client.Open(ctx, path)
 	if err != nil {
 		return nil, time.Time{}, 0, fmt.Errorf("read %s: %w", path, err)
 	}
-	return r, r.Attrs.LastModified, r.Attrs.Generation, nil
+	attrs := gcs.ReaderAttrs(r)
+	if attrs == nil {
+		return r, time.Time{}, 0, nil
+	}
+	return r, attrs.LastModified, attrs.Generation, nil
 }
 
 // updateDashboard will summarize all the tabs (through errors), returning an error if any fail to summarize.
@@ -198,6 +205,27 @@ func updateDashboard(ctx context.Context, dash *configpb.Dashboard, finder group
 	return &sum, err
 }
 
+// SummarizeTab summarizes the dashboard tab from the grid state of its test group at gridPath.
+//
+// Returns a summary alerting that the tab failed to summarize along with any error.
+func SummarizeTab(ctx context.Context, client gcs.Client, dashboard string, tab *configpb.DashboardTab, group *configpb.TestGroup, gridPath gcs.Path) (*summarypb.DashboardTabSummary, error) {
+	finder := func(name string) (*configpb.TestGroup, gridReader, error) {
+		if name != group.GetName() {
+			return nil, nil, nil
+		}
+		reader := func(ctx context.Context) (io.ReadCloser, time.Time, int64, error) {
+			return pathReader(ctx, client, gridPath)
+		}
+		return group, reader, nil
+	}
+	sum, err := updateTab(ctx, tab, finder)
+	if err != nil {
+		return problemTab(dashboard, tab.Name), err
+	}
+	sum.DashboardName = dashboard
+	return sum, nil
+}
+
 // problemTab summarizes a tab that cannot summarize
 func problemTab(dashboardName, tabName string) *summarypb.DashboardTabSummary {
 	return &summarypb.DashboardTabSummary{
@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+var (
+	timeRE   = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?|\d{2}:\d{2}:\d{2}(\.\d+)?`)
+	uuidRE   = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	hexRE    = regexp.MustCompile(`(?i)\b(0x[0-9a-f]+|[0-9a-f]{8,})\b`)
+	pathRE   = regexp.MustCompile(`[\w.\-@]*(/[\w.\-@]+)+/?`)
+	numberRE = regexp.MustCompile(`\d+(\.\d+)?`)
+	spaceRE  = regexp.MustCompile(`\s+`)
+)
+
+// normalizeMessage strips the parts of a failure message that vary between otherwise identical failures.
+//
+// Timestamps, hex ids, paths and numbers are replaced with placeholders.
+func normalizeMessage(msg string) string {
+	msg = timeRE.ReplaceAllString(msg, "<time>")
+	msg = uuidRE.ReplaceAllString(msg, "<hex>")
+	msg = hexRE.ReplaceAllStringFunc(msg, func(s string) string {
+		switch {
+		case strings.HasPrefix(strings.ToLower(s), "0x"):
+		case !strings.ContainsAny(s, "0123456789"): // a long word
+			return s
+		case !strings.ContainsAny(strings.ToLower(s), "abcdef"): // a long number
+			return s
+		}
+		return "<hex>"
+	})
+	msg = pathRE.ReplaceAllString(msg, "<path>")
+	msg = numberRE.ReplaceAllString(msg, "<num>")
+	return strings.TrimSpace(spaceRE.ReplaceAllString(msg, " "))
+}
+
+type clusterKey struct {
+	status  int32
+	message string
+}
+
+type columnKey struct {
+	build   string
+	started float64
+}
+
+func keyOf(col *statepb.Column) columnKey {
+	return columnKey{build: col.Build, started: col.Started}
+}
+
+// clusters accumulates the cells of each cluster.
+type clusters struct {
+	cells map[clusterKey]map[string][]int32
+}
+
+func (c *clusters) add(key clusterKey, row string, idx int32) {
+	if c.cells == nil {
+		c.cells = map[clusterKey]map[string][]int32{}
+	}
+	rows, ok := c.cells[key]
+	if !ok {
+		rows = map[string][]int32{}
+		c.cells[key] = rows
+	}
+	rows[row] = append(rows[row], idx)
+}
+
+// list returns the clusters, largest first.
+func (c *clusters) list() []*statepb.Cluster {
+	type sized struct {
+		cluster *statepb.Cluster
+		cells   int
+	}
+	var out []sized
+	for key, rows := range c.cells {
+		s := sized{
+			cluster: &statepb.Cluster{
+				TestStatus: key.status,
+				Message:    key.message,
+			},
+		}
+		for name, indices := range rows {
+			sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+			s.cluster.ClusterRow = append(s.cluster.ClusterRow, &statepb.ClusterRow{
+				DisplayName: name,
+				Index:       indices,
+			})
+			s.cells += len(indices)
+		}
+		sort.Slice(s.cluster.ClusterRow, func(i, j int) bool {
+			return s.cluster.ClusterRow[i].DisplayName < s.cluster.ClusterRow[j].DisplayName
+		})
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].cells != out[j].cells {
+			return out[i].cells > out[j].cells
+		}
+		if out[i].cluster.TestStatus != out[j].cluster.TestStatus {
+			return out[i].cluster.TestStatus < out[j].cluster.TestStatus
+		}
+		return out[i].cluster.Message < out[j].cluster.Message
+	})
+	var ret []*statepb.Cluster
+	for _, s := range out {
+		ret = append(ret, s.cluster)
+	}
+	return ret
+}
+
+// clusterGrid groups the failing cells of the grid by status and normalized message.
+//
+// The first fresh columns were read during this update. Clusters in the old grid
+// are carried forward for the remaining columns it already clustered (those started
+// by its most_recent_cluster_timestamp), so only the other columns are clustered again.
+func clusterGrid(grid *statepb.Grid, cols []inflatedColumn, fresh int, old *statepb.Grid) {
+	since := old.GetMostRecentClusterTimestamp()
+
+	carried := map[columnKey]int32{}
+	for i := fresh; i < len(cols); i++ {
+		if col := cols[i].column; col.Started <= since {
+			carried[keyOf(col)] = int32(i)
+		}
+	}
+
+	var c clusters
+	oldCols := old.GetColumns()
+	for _, cluster := range old.GetCluster() {
+		key := clusterKey{status: cluster.TestStatus, message: cluster.Message}
+		for _, row := range cluster.ClusterRow {
+			for _, idx := range row.Index {
+				if int(idx) >= len(oldCols) {
+					continue
+				}
+				if n, ok := carried[keyOf(oldCols[idx])]; ok {
+					c.add(key, row.DisplayName, n)
+				}
+			}
+		}
+	}
+
+	mostRecent := since
+	for i, col := range cols {
+		if col.column.Started > mostRecent {
+			mostRecent = col.column.Started
+		}
+		if _, ok := carried[keyOf(col.column)]; ok {
+			continue
+		}
+		for name, cell := range col.cells {
+			if !result.IsFailingResult(cell.result) {
+				continue
+			}
+			c.add(clusterKey{status: int32(cell.result), message: normalizeMessage(cell.message)}, name, int32(i))
+		}
+	}
+
+	grid.Cluster = c.list()
+	grid.MostRecentClusterTimestamp = mostRecent
+}
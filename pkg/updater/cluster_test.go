@@ -0,0 +1,302 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func TestNormalizeMessage(t *testing.T) {
+	cases := []struct {
+		name     string
+		msg      string
+		expected string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "plain messages are unchanged",
+			msg:      "connection refused",
+			expected: "connection refused",
+		},
+		{
+			name:     "numbers",
+			msg:      "expected 3 pods, got 12 after 1.5s",
+			expected: "expected <num> pods, got <num> after <num>s",
+		},
+		{
+			name:     "timestamps",
+			msg:      "I0102 2020-01-02T03:04:05.123Z timed out at 10:11:12",
+			expected: "I<num> <time> timed out at <time>",
+		},
+		{
+			name:     "hex ids",
+			msg:      "pod 0xc000123 uid 123e4567-e89b-12d3-a456-426614174000 commit deadbeef1234",
+			expected: "pod <hex> uid <hex> commit <hex>",
+		},
+		{
+			name:     "long words are not hex",
+			msg:      "unacceptable",
+			expected: "unacceptable",
+		},
+		{
+			name:     "paths",
+			msg:      "open /tmp/build-123/junit.xml: no such file",
+			expected: "open <path>: no such file",
+		},
+		{
+			name:     "collapse whitespace",
+			msg:      "  foo \n\t bar ",
+			expected: "foo bar",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := normalizeMessage(tc.msg); actual != tc.expected {
+				t.Errorf("normalizeMessage(%q) got %q, want %q", tc.msg, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestClusterGrid(t *testing.T) {
+	fail := func(msg string) cell {
+		return cell{result: statuspb.TestStatus_FAIL, message: msg}
+	}
+	pass := cell{result: statuspb.TestStatus_PASS}
+	cases := []struct {
+		name     string
+		cols     []inflatedColumn
+		fresh    int
+		old      *statepb.Grid
+		expected *statepb.Grid
+	}{
+		{
+			name:     "basically works",
+			expected: &statepb.Grid{},
+		},
+		{
+			name:  "group failures by status and normalized message",
+			fresh: 3,
+			cols: []inflatedColumn{
+				{
+					column: &statepb.Column{Build: "3", Started: 3000},
+					cells: map[string]cell{
+						"a": fail("timeout after 30s"),
+						"b": fail("timeout after 5s"),
+						"c": pass,
+					},
+				},
+				{
+					column: &statepb.Column{Build: "2", Started: 2000},
+					cells: map[string]cell{
+						"a": fail("timeout after 1s"),
+						"b": {result: statuspb.TestStatus_TIMED_OUT, message: "timeout after 1s"},
+						"c": fail("boom"),
+					},
+				},
+				{
+					column: &statepb.Column{Build: "1", Started: 1000},
+					cells: map[string]cell{
+						"a": pass,
+						"b": {result: statuspb.TestStatus_RUNNING},
+						"c": {result: statuspb.TestStatus_FLAKY},
+					},
+				},
+			},
+			expected: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "timeout after <num>s",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0, 1}},
+							{DisplayName: "b", Index: []int32{0}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_TIMED_OUT),
+						Message:    "timeout after <num>s",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "b", Index: []int32{1}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "c", Index: []int32{1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 3000,
+			},
+		},
+		{
+			name:  "carry old clusters forward",
+			fresh: 1,
+			cols: []inflatedColumn{
+				{
+					column: &statepb.Column{Build: "3", Started: 3000},
+					cells: map[string]cell{
+						"a": fail("boom 3"),
+					},
+				},
+				{
+					column: &statepb.Column{Build: "2", Started: 2000},
+					cells: map[string]cell{
+						"a": fail("ignored since already clustered"),
+					},
+				},
+			},
+			old: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Build: "2", Started: 2000},
+					{Build: "1", Started: 1000},
+				},
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom <num>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0, 1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 2000,
+			},
+			expected: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom <num>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0, 1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 3000,
+			},
+		},
+		{
+			name:  "recluster columns read again",
+			fresh: 1,
+			cols: []inflatedColumn{
+				{
+					column: &statepb.Column{Build: "2", Started: 2000},
+					cells: map[string]cell{
+						"a": fail("now it failed"),
+					},
+				},
+				{
+					column: &statepb.Column{Build: "1", Started: 1000},
+					cells: map[string]cell{
+						"a": fail("old"),
+					},
+				},
+			},
+			old: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Build: "2", Started: 2000},
+					{Build: "1", Started: 1000},
+				},
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_BUILD_FAIL),
+						Message:    "stale",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "old",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 2000,
+			},
+			expected: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "now it failed",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "old",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 2000,
+			},
+		},
+		{
+			name: "cluster old columns without clusters",
+			cols: []inflatedColumn{
+				{
+					column: &statepb.Column{Build: "1", Started: 1000},
+					cells: map[string]cell{
+						"a": fail("boom"),
+					},
+				},
+			},
+			old: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Build: "1", Started: 1000},
+				},
+			},
+			expected: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 1000,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual statepb.Grid
+			clusterGrid(&actual, tc.cols, tc.fresh, tc.old)
+			if diff := cmp.Diff(&actual, tc.expected, protocmp.Transform()); diff != "" {
+				t.Errorf("clusterGrid() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// commitHeader is the configuration value of the column header the overrides replace.
+const commitHeader = "Commit"
+
+// commitOptions configures what takes the place of the commit in the Commit header.
+type commitOptions struct {
+	label       *regexp.Regexp // match labels formatted as key=value
+	configValue string         // use this finished.json metadata key
+	strftime    string         // format the start time
+}
+
+func makeCommitOptions(tg configpb.TestGroup) (commitOptions, error) {
+	opt := commitOptions{
+		configValue: tg.CommitOverrideConfigurationValue,
+		strftime:    tg.CommitOverrideStrftime,
+	}
+	if expr := tg.CommitOverrideLabelPattern; expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return opt, fmt.Errorf("commit_override_label_pattern: %w", err)
+		}
+		opt.label = re
+	}
+	return opt, nil
+}
+
+// override returns the value that replaces the commit, if any.
+//
+// Prefers a matching label, then the configuration value and finally the formatted start time.
+func (o commitOptions) override(started gcs.Started, finished gcs.Finished, meta map[string]string) (string, bool) {
+	if o.label != nil {
+		if val, ok := matchLabel(o.label, started, finished); ok {
+			return val, true
+		}
+	}
+	if o.configValue != "" {
+		if val, ok := meta[o.configValue]; ok {
+			return val, true
+		}
+	}
+	if o.strftime != "" && started.Timestamp > 0 {
+		return strftime(o.strftime, time.Unix(started.Timestamp, 0).UTC()), true
+	}
+	return "", false
+}
+
+// matchLabel returns the first captured group (or else the entire match) of the first matching label.
+//
+// Labels are matched in sorted order as key=value, with finished labels overriding started ones.
+func matchLabel(re *regexp.Regexp, started gcs.Started, finished gcs.Finished) (string, bool) {
+	labels := map[string]string{}
+	for k, v := range started.Labels {
+		labels[k] = v
+	}
+	for k, v := range finished.Labels {
+		labels[k] = v
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		match := re.FindStringSubmatch(k + "=" + labels[k])
+		if match == nil {
+			continue
+		}
+		if len(match) > 1 {
+			return match[1], true
+		}
+		return match[0], true
+	}
+	return "", false
+}
+
+// strftimeLayouts maps strftime conversion specifiers to time.Format layouts.
+var strftimeLayouts = map[byte]string{
+	'a': "Mon",
+	'A': "Monday",
+	'b': "Jan",
+	'B': "January",
+	'd': "02",
+	'D': "01/02/06",
+	'e': "_2",
+	'F': "2006-01-02",
+	'h': "Jan",
+	'H': "15",
+	'I': "03",
+	'm': "01",
+	'M': "04",
+	'p': "PM",
+	'R': "15:04",
+	'S': "05",
+	'T': "15:04:05",
+	'y': "06",
+	'Y': "2006",
+	'z': "-0700",
+	'Z': "MST",
+}
+
+// strftime formats t according to the strftime specifiers in format.
+//
+// Unsupported specifiers are left as is.
+func strftime(format string, t time.Time) string {
+	var sb strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			sb.WriteByte(format[i])
+			continue
+		}
+		i++
+		switch spec := format[i]; spec {
+		case '%':
+			sb.WriteByte('%')
+		case 'j':
+			fmt.Fprintf(&sb, "%03d", t.YearDay())
+		case 's':
+			sb.WriteString(strconv.FormatInt(t.Unix(), 10))
+		default:
+			layout, ok := strftimeLayouts[spec]
+			if !ok {
+				sb.WriteByte('%')
+				sb.WriteByte(spec)
+				continue
+			}
+			sb.WriteString(t.Format(layout))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestCommitOverride(t *testing.T) {
+	started := gcs.Started{
+		Started: metadata.Started{
+			Timestamp: time.Date(2020, 7, 4, 13, 5, 9, 0, time.UTC).Unix(),
+			Labels: map[string]string{
+				"branch":  "main",
+				"release": "v1.19",
+			},
+		},
+	}
+	finished := gcs.Finished{
+		Finished: metadata.Finished{
+			Labels: map[string]string{
+				"release": "v1.20",
+			},
+		},
+	}
+	meta := map[string]string{
+		"build": "b123",
+	}
+	cases := []struct {
+		name     string
+		group    configpb.TestGroup
+		started  *gcs.Started
+		expected string
+		ok       bool
+		err      bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "reject bad label pattern",
+			group: configpb.TestGroup{
+				CommitOverrideLabelPattern: "(",
+			},
+			err: true,
+		},
+		{
+			name: "label captured group",
+			group: configpb.TestGroup{
+				CommitOverrideLabelPattern: `^release=v(.+)$`,
+			},
+			expected: "1.20",
+			ok:       true,
+		},
+		{
+			name: "entire label match",
+			group: configpb.TestGroup{
+				CommitOverrideLabelPattern: `branch=\w+`,
+			},
+			expected: "branch=main",
+			ok:       true,
+		},
+		{
+			name: "configuration value",
+			group: configpb.TestGroup{
+				CommitOverrideConfigurationValue: "build",
+			},
+			expected: "b123",
+			ok:       true,
+		},
+		{
+			name: "strftime",
+			group: configpb.TestGroup{
+				CommitOverrideStrftime: "%Y-%m-%d %H:%M",
+			},
+			expected: "2020-07-04 13:05",
+			ok:       true,
+		},
+		{
+			name: "prefer label over configuration value",
+			group: configpb.TestGroup{
+				CommitOverrideLabelPattern:       `^release=(.+)$`,
+				CommitOverrideConfigurationValue: "build",
+				CommitOverrideStrftime:           "%F",
+			},
+			expected: "v1.20",
+			ok:       true,
+		},
+		{
+			name: "fall back to strftime",
+			group: configpb.TestGroup{
+				CommitOverrideLabelPattern:       `^nightly=`,
+				CommitOverrideConfigurationValue: "missing",
+				CommitOverrideStrftime:           "%F",
+			},
+			expected: "2020-07-04",
+			ok:       true,
+		},
+		{
+			name: "no strftime without a start time",
+			group: configpb.TestGroup{
+				CommitOverrideStrftime: "%F",
+			},
+			started: &gcs.Started{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := makeCommitOptions(tc.group)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("makeCommitOptions() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("makeCommitOptions() failed to return an error")
+			}
+			s := started
+			if tc.started != nil {
+				s = *tc.started
+			}
+			actual, ok := opt.override(s, finished, meta)
+			if actual != tc.expected || ok != tc.ok {
+				t.Errorf("override() got %q, %t, want %q, %t", actual, ok, tc.expected, tc.ok)
+			}
+		})
+	}
+}
+
+func TestCommitHeader(t *testing.T) {
+	headers := []*configpb.TestGroup_ColumnHeader{
+		{ConfigurationValue: "Commit"},
+		{ConfigurationValue: "hello"},
+	}
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{
+				Timestamp: 300,
+				Labels: map[string]string{
+					"release": "v1.20",
+				},
+			},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Metadata: metadata.Metadata{
+					"hello":             "world",
+					"build":             "b123",
+					metadata.JobVersion: "1.2.3",
+				},
+			},
+		},
+	}
+	cases := []struct {
+		name     string
+		commit   commitOptions
+		expected string
+	}{
+		{
+			name:     "job version by default",
+			expected: "1.2.3",
+		},
+		{
+			name: "label pattern",
+			commit: commitOptions{
+				label: regexp.MustCompile(`^release=v(.+)$`),
+			},
+			expected: "1.20",
+		},
+		{
+			name: "configuration value",
+			commit: commitOptions{
+				label:       regexp.MustCompile(`^nightly=`),
+				configValue: "build",
+			},
+			expected: "b123",
+		},
+		{
+			name: "strftime",
+			commit: commitOptions{
+				configValue: "do not have this one",
+				strftime:    "%Y-%m-%d",
+			},
+			expected: "1970-01-01",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := inflatedColumn{
+				column: &statepb.Column{
+					Build:   "hello",
+					Started: 300 * 1000,
+					Extra: []string{
+						tc.expected,
+						"world",
+					},
+				},
+				cells: map[string]cell{
+					"Overall": {
+						result:  statuspb.TestStatus_TIMED_OUT,
+						icon:    "T",
+						message: "Build did not complete within 24 hours",
+					},
+				},
+			}
+			actual := convertResult(nameConfig{}, "hello", headers, result, groupOptions{commit: tc.commit})
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("convertResult() got %v, want %v", actual, expected)
+			}
+		})
+	}
+}
+
+func TestStrftime(t *testing.T) {
+	when := time.Date(2020, 2, 3, 16, 5, 9, 0, time.UTC)
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   "",
+			expected: "",
+		},
+		{
+			format:   "nightly",
+			expected: "nightly",
+		},
+		{
+			format:   "%Y%m%d",
+			expected: "20200203",
+		},
+		{
+			format:   "%a %b %e %I:%M:%S %p %Z",
+			expected: "Mon Feb  3 04:05:09 PM UTC",
+		},
+		{
+			format:   "%F %T day %j",
+			expected: "2020-02-03 16:05:09 day 034",
+		},
+		{
+			format:   "100%% on %D",
+			expected: "100% on 02/03/20",
+		},
+		{
+			format:   "%Q unknown, trailing %",
+			expected: "%Q unknown, trailing %",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.format, func(t *testing.T) {
+			if actual := strftime(tc.format, when); actual != tc.expected {
+				t.Errorf("strftime(%q) got %q, want %q", tc.format, actual, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+// resultFields maps test_result_field names to junit result values.
+var resultFields = map[string]func(junit.Result) []string{
+	"name": func(r junit.Result) []string {
+		return []string{r.Name}
+	},
+	"class_name": func(r junit.Result) []string {
+		return []string{r.ClassName}
+	},
+	"duration": func(r junit.Result) []string {
+		return []string{strconv.FormatFloat(r.Time, 'f', -1, 64)}
+	},
+	"failure_count": func(r junit.Result) []string {
+		if r.Failure != nil {
+			return []string{"1"}
+		}
+		return []string{"0"}
+	},
+	"output": func(r junit.Result) []string {
+		if r.Output == nil {
+			return nil
+		}
+		return []string{*r.Output}
+	},
+}
+
+// errorFields maps test_result_error_field names to values of the first junit error.
+var errorFields = map[string]func(junit.Result) []string{
+	"error_message": func(r junit.Result) []string {
+		if r.Failure == nil {
+			return nil
+		}
+		return []string{*r.Failure}
+	},
+}
+
+// rule is a custom evaluator rule, ready to evaluate results.
+type rule struct {
+	status      statuspb.TestStatus
+	comparisons []comparison
+}
+
+// comparison holds a test result comparison along with its compiled regex.
+type comparison struct {
+	trc *evalpb.TestResultComparison
+	re  *regexp.Regexp // set for OP_REGEX string comparisons
+}
+
+// makeRules prepares the rules for evaluation.
+//
+// Returns an error when an OP_REGEX comparison does not compile.
+func makeRules(rules []*evalpb.Rule) ([]rule, error) {
+	out := make([]rule, 0, len(rules))
+	for i, r := range rules {
+		compiled := rule{
+			status:      r.ComputedStatus,
+			comparisons: make([]comparison, 0, len(r.TestResultComparisons)),
+		}
+		for j, trc := range r.TestResultComparisons {
+			c, err := makeComparison(trc)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d comparison %d: %w", i, j, err)
+			}
+			compiled.comparisons = append(compiled.comparisons, *c)
+		}
+		out = append(out, compiled)
+	}
+	return out, nil
+}
+
+// makeComparison compiles the regex of an OP_REGEX comparison.
+//
+// Warns about OP_UNKNOWN comparisons, which evaluate as OP_EQ.
+func makeComparison(trc *evalpb.TestResultComparison) (*comparison, error) {
+	c := comparison{trc: trc}
+	cmp := trc.GetComparison()
+	switch cmp.GetOp() {
+	case evalpb.Comparison_OP_UNKNOWN:
+		if cmp != nil {
+			logrus.WithField("comparison", cmp).Warning("Treating OP_UNKNOWN as OP_EQ")
+		}
+	case evalpb.Comparison_OP_REGEX:
+		if _, ok := cmp.GetComparisonValue().(*evalpb.Comparison_StringValue); !ok {
+			break
+		}
+		re, err := regexp.Compile(cmp.GetStringValue())
+		if err != nil {
+			return nil, fmt.Errorf("bad regex: %w", err)
+		}
+		c.re = re
+	}
+	return &c, nil
+}
+
+// customStatus returns the computed_status of the first rule matching the result.
+//
+// Returns nil when no rule matches.
+func customStatus(rules []rule, r junit.Result) *statuspb.TestStatus {
+	for _, rule := range rules {
+		if matchRule(rule, r) {
+			s := rule.status
+			return &s
+		}
+	}
+	return nil
+}
+
+// matchRule returns true when every comparison in the rule matches the result.
+func matchRule(rule rule, r junit.Result) bool {
+	if len(rule.comparisons) == 0 {
+		return false
+	}
+	for _, c := range rule.comparisons {
+		if !matchComparison(c, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchComparison returns true when any value the comparison selects matches.
+//
+// Comparisons against missing values or unknown fields never match.
+func matchComparison(c comparison, r junit.Result) bool {
+	if c.trc.GetComparison() == nil {
+		return false
+	}
+	for _, val := range comparisonValues(c.trc, r) {
+		if compare(c, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// comparisonValues returns the values of the result that the comparison evaluates.
+func comparisonValues(trc *evalpb.TestResultComparison, r junit.Result) []string {
+	switch info := trc.GetTestResultInfo().(type) {
+	case *evalpb.TestResultComparison_PropertyKey:
+		if r.Properties == nil {
+			return nil
+		}
+		var vals []string
+		for _, p := range r.Properties.PropertyList {
+			if p.Name == info.PropertyKey {
+				vals = append(vals, p.Value)
+			}
+		}
+		return vals
+	case *evalpb.TestResultComparison_TestResultField:
+		if f, ok := resultFields[info.TestResultField]; ok {
+			return f(r)
+		}
+	case *evalpb.TestResultComparison_TestResultErrorField:
+		if f, ok := errorFields[info.TestResultErrorField]; ok {
+			return f(r)
+		}
+	}
+	return nil
+}
+
+// compare returns true if the comparison value <op> the result value is true.
+//
+// So {op: OP_LT, numerical_value: 5} matches a value of 7, as 5 < 7.
+func compare(c comparison, value string) bool {
+	cmp := c.trc.GetComparison()
+	if v, ok := cmp.GetComparisonValue().(*evalpb.Comparison_NumericalValue); ok {
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+		return compareNumbers(cmp.Op, v.NumericalValue, n)
+	}
+	if cmp.Op == evalpb.Comparison_OP_REGEX {
+		return c.re != nil && c.re.MatchString(value)
+	}
+	return compareStrings(cmp.Op, cmp.GetStringValue(), value)
+}
+
+// compareNumbers returns true if want <op> have is true.
+func compareNumbers(op evalpb.Comparison_Operator, want, have float64) bool {
+	switch op {
+	case evalpb.Comparison_OP_UNKNOWN, evalpb.Comparison_OP_EQ:
+		return want == have
+	case evalpb.Comparison_OP_NE:
+		return want != have
+	case evalpb.Comparison_OP_LT:
+		return want < have
+	case evalpb.Comparison_OP_LE:
+		return want <= have
+	case evalpb.Comparison_OP_GT:
+		return want > have
+	case evalpb.Comparison_OP_GE:
+		return want >= have
+	}
+	return false
+}
+
+// compareStrings returns true if want <op> have is true.
+//
+// OP_CONTAINS matches when want contains have.
+func compareStrings(op evalpb.Comparison_Operator, want, have string) bool {
+	switch op {
+	case evalpb.Comparison_OP_UNKNOWN, evalpb.Comparison_OP_EQ:
+		return have == want
+	case evalpb.Comparison_OP_NE:
+		return have != want
+	case evalpb.Comparison_OP_STARTS_WITH:
+		return strings.HasPrefix(have, want)
+	case evalpb.Comparison_OP_CONTAINS:
+		return strings.Contains(want, have)
+	}
+	return false
+}
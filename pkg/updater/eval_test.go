@@ -0,0 +1,555 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func strCmp(op evalpb.Comparison_Operator, val string) *evalpb.Comparison {
+	return &evalpb.Comparison{
+		Op:              op,
+		ComparisonValue: &evalpb.Comparison_StringValue{StringValue: val},
+	}
+}
+
+func numCmp(op evalpb.Comparison_Operator, val float64) *evalpb.Comparison {
+	return &evalpb.Comparison{
+		Op:              op,
+		ComparisonValue: &evalpb.Comparison_NumericalValue{NumericalValue: val},
+	}
+}
+
+func propertyCmp(key string, cmp *evalpb.Comparison) *evalpb.TestResultComparison {
+	return &evalpb.TestResultComparison{
+		Comparison:     cmp,
+		TestResultInfo: &evalpb.TestResultComparison_PropertyKey{PropertyKey: key},
+	}
+}
+
+func fieldCmp(field string, cmp *evalpb.Comparison) *evalpb.TestResultComparison {
+	return &evalpb.TestResultComparison{
+		Comparison:     cmp,
+		TestResultInfo: &evalpb.TestResultComparison_TestResultField{TestResultField: field},
+	}
+}
+
+func errorCmp(field string, cmp *evalpb.Comparison) *evalpb.TestResultComparison {
+	return &evalpb.TestResultComparison{
+		Comparison:     cmp,
+		TestResultInfo: &evalpb.TestResultComparison_TestResultErrorField{TestResultErrorField: field},
+	}
+}
+
+// mustMakeRules returns the rules prepared for evaluation, panicking on error.
+func mustMakeRules(rules ...*evalpb.Rule) []rule {
+	out, err := makeRules(rules)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func TestCustomStatus(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	pstatus := func(s statuspb.TestStatus) *statuspb.TestStatus {
+		return &s
+	}
+	cases := []struct {
+		name     string
+		rules    []*evalpb.Rule
+		result   junit.Result
+		expected *statuspb.TestStatus
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "rules without comparisons do not match",
+			rules: []*evalpb.Rule{
+				{ComputedStatus: statuspb.TestStatus_TOOL_FAIL},
+			},
+		},
+		{
+			name: "match name",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_TOOL_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_EQ, "hello")),
+					},
+				},
+			},
+			result:   junit.Result{Name: "hello"},
+			expected: pstatus(statuspb.TestStatus_TOOL_FAIL),
+		},
+		{
+			name: "unknown operator means equals",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_TOOL_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_UNKNOWN, "hello")),
+					},
+				},
+			},
+			result:   junit.Result{Name: "hello"},
+			expected: pstatus(statuspb.TestStatus_TOOL_FAIL),
+		},
+		{
+			name: "first matching rule wins",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_TOOL_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_EQ, "nope")),
+					},
+				},
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_STARTS_WITH, "hel")),
+					},
+				},
+				{
+					ComputedStatus: statuspb.TestStatus_BUILD_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_CONTAINS, "well hello there")),
+					},
+				},
+			},
+			result:   junit.Result{Name: "hello"},
+			expected: pstatus(statuspb.TestStatus_CATEGORIZED_FAIL),
+		},
+		{
+			name: "every comparison must match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_TOOL_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_EQ, "hello")),
+						fieldCmp("class_name", strCmp(evalpb.Comparison_OP_EQ, "world")),
+					},
+				},
+			},
+			result: junit.Result{Name: "hello", ClassName: "other"},
+		},
+		{
+			name: "match property regex",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						propertyCmp("node", strCmp(evalpb.Comparison_OP_REGEX, `^bad-node-\d+$`)),
+					},
+				},
+			},
+			result: junit.Result{
+				Properties: &junit.Properties{
+					PropertyList: []junit.Property{
+						{Name: "node", Value: "good-node-1"},
+						{Name: "node", Value: "bad-node-7"},
+					},
+				},
+			},
+			expected: pstatus(statuspb.TestStatus_CATEGORIZED_FAIL),
+		},
+		{
+			name: "missing properties do not match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						propertyCmp("node", strCmp(evalpb.Comparison_OP_NE, "hello")),
+					},
+				},
+			},
+		},
+		{
+			name: "match numerical property",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						propertyCmp("retries", numCmp(evalpb.Comparison_OP_LE, 3)),
+					},
+				},
+			},
+			result: junit.Result{
+				Properties: &junit.Properties{
+					PropertyList: []junit.Property{
+						{Name: "retries", Value: "3"},
+					},
+				},
+			},
+			expected: pstatus(statuspb.TestStatus_CATEGORIZED_FAIL),
+		},
+		{
+			name: "non-numerical values do not match numerical comparisons",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						propertyCmp("retries", numCmp(evalpb.Comparison_OP_NE, 3)),
+					},
+				},
+			},
+			result: junit.Result{
+				Properties: &junit.Properties{
+					PropertyList: []junit.Property{
+						{Name: "retries", Value: "many"},
+					},
+				},
+			},
+		},
+		{
+			name: "match duration",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("duration", numCmp(evalpb.Comparison_OP_LT, 60)),
+						fieldCmp("failure_count", numCmp(evalpb.Comparison_OP_EQ, 1)),
+					},
+				},
+			},
+			result: junit.Result{
+				Time:    61.5,
+				Failure: pstr("deadline exceeded"),
+			},
+			expected: pstatus(statuspb.TestStatus_TIMED_OUT),
+		},
+		{
+			name: "match error message",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						errorCmp("error_message", strCmp(evalpb.Comparison_OP_REGEX, "quota")),
+					},
+				},
+			},
+			result: junit.Result{
+				Failure: pstr("exceeded quota for project"),
+			},
+			expected: pstatus(statuspb.TestStatus_CATEGORIZED_FAIL),
+		},
+		{
+			name: "error message ignores system-err",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						errorCmp("error_message", strCmp(evalpb.Comparison_OP_REGEX, "quota")),
+					},
+				},
+			},
+			result: junit.Result{
+				Error: pstr("exceeded quota for project"),
+			},
+		},
+		{
+			name: "unknown fields do not match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("does not exist", strCmp(evalpb.Comparison_OP_NE, "anything")),
+					},
+				},
+			},
+		},
+		{
+			name: "comparisons without a comparison do not match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", nil),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, err := makeRules(tc.rules)
+			if err != nil {
+				t.Fatalf("makeRules() failed: %v", err)
+			}
+			actual := customStatus(rules, tc.result)
+			switch {
+			case actual == nil && tc.expected == nil:
+			case actual == nil, tc.expected == nil, *actual != *tc.expected:
+				t.Errorf("customStatus() got %v, want %v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCustomEvaluatorRules(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	now := time.Now().Unix()
+	result := finishedAt(now, now+1, true)
+	result.suites = suitesWith(
+		junit.Result{
+			Name:    "infra flake",
+			Failure: pstr("could not schedule pod"),
+		},
+		junit.Result{
+			Name:    "real failure",
+			Failure: pstr("expected 1 got 2"),
+		},
+		junit.Result{
+			Name:       "tooling",
+			Properties: props("tool", "broken"),
+		},
+	)
+	opt := groupOptions{
+		rules: mustMakeRules(
+			&evalpb.Rule{
+				ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+				TestResultComparisons: []*evalpb.TestResultComparison{
+					errorCmp("error_message", strCmp(evalpb.Comparison_OP_REGEX, "schedule")),
+				},
+			},
+			&evalpb.Rule{
+				ComputedStatus: statuspb.TestStatus_TOOL_FAIL,
+				TestResultComparisons: []*evalpb.TestResultComparison{
+					propertyCmp("tool", strCmp(evalpb.Comparison_OP_EQ, "broken")),
+				},
+			},
+		),
+	}
+	expected := passedColumn(now, map[string]cell{
+		"infra flake": {
+			result:  statuspb.TestStatus_CATEGORIZED_FAIL,
+			icon:    "F",
+			message: "could not schedule pod",
+		},
+		"real failure": {
+			result:  statuspb.TestStatus_FAIL,
+			icon:    "F",
+			message: "expected 1 got 2",
+		},
+		"tooling": {
+			result: statuspb.TestStatus_TOOL_FAIL,
+		},
+	})
+
+	if actual := convertResult(testNames, "", nil, result, opt); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("convertResult() got %v, want %v", actual, expected)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name     string
+		cmp      *evalpb.Comparison
+		value    string
+		expected bool
+	}{
+		{
+			name:     "basically works",
+			cmp:      &evalpb.Comparison{},
+			expected: true,
+		},
+		{
+			name:     "string equal",
+			cmp:      strCmp(evalpb.Comparison_OP_EQ, "hello"),
+			value:    "hello",
+			expected: true,
+		},
+		{
+			name:  "string not equal",
+			cmp:   strCmp(evalpb.Comparison_OP_NE, "hello"),
+			value: "hello",
+		},
+		{
+			name:     "string regex",
+			cmp:      strCmp(evalpb.Comparison_OP_REGEX, "h.l+o"),
+			value:    "well hello there",
+			expected: true,
+		},
+		{
+			name:     "string starts with",
+			cmp:      strCmp(evalpb.Comparison_OP_STARTS_WITH, "hell"),
+			value:    "hello",
+			expected: true,
+		},
+		{
+			name:  "string does not start with",
+			cmp:   strCmp(evalpb.Comparison_OP_STARTS_WITH, "ello"),
+			value: "hello",
+		},
+		{
+			name:     "string contained in comparison value",
+			cmp:      strCmp(evalpb.Comparison_OP_CONTAINS, "hello"),
+			value:    "ell",
+			expected: true,
+		},
+		{
+			name:  "string not contained in comparison value",
+			cmp:   strCmp(evalpb.Comparison_OP_CONTAINS, "ell"),
+			value: "hello",
+		},
+		{
+			name:  "strings do not support less than",
+			cmp:   strCmp(evalpb.Comparison_OP_LT, "b"),
+			value: "a",
+		},
+		{
+			name:     "number equal",
+			cmp:      numCmp(evalpb.Comparison_OP_EQ, 1.5),
+			value:    "1.50",
+			expected: true,
+		},
+		{
+			name:     "number not equal",
+			cmp:      numCmp(evalpb.Comparison_OP_NE, 1.5),
+			value:    "2",
+			expected: true,
+		},
+		{
+			name:     "number less than",
+			cmp:      numCmp(evalpb.Comparison_OP_LT, 2),
+			value:    "3",
+			expected: true,
+		},
+		{
+			name:  "comparison value is the left operand",
+			cmp:   numCmp(evalpb.Comparison_OP_LT, 5),
+			value: "3",
+		},
+		{
+			name:  "number not less than",
+			cmp:   numCmp(evalpb.Comparison_OP_LT, 2),
+			value: "2",
+		},
+		{
+			name:     "number less than or equal",
+			cmp:      numCmp(evalpb.Comparison_OP_LE, 2),
+			value:    "2",
+			expected: true,
+		},
+		{
+			name:  "number not less than or equal",
+			cmp:   numCmp(evalpb.Comparison_OP_LE, 2),
+			value: "1",
+		},
+		{
+			name:     "number greater than",
+			cmp:      numCmp(evalpb.Comparison_OP_GT, 2),
+			value:    "1",
+			expected: true,
+		},
+		{
+			name:  "number not greater than",
+			cmp:   numCmp(evalpb.Comparison_OP_GT, 2),
+			value: "2",
+		},
+		{
+			name:     "number greater than or equal",
+			cmp:      numCmp(evalpb.Comparison_OP_GE, 2),
+			value:    "2",
+			expected: true,
+		},
+		{
+			name:  "number not greater than or equal",
+			cmp:   numCmp(evalpb.Comparison_OP_GE, 2),
+			value: "3",
+		},
+		{
+			name:  "numbers do not support contains",
+			cmp:   numCmp(evalpb.Comparison_OP_CONTAINS, 2),
+			value: "2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := makeComparison(fieldCmp("name", tc.cmp))
+			if err != nil {
+				t.Fatalf("makeComparison() failed: %v", err)
+			}
+			if actual := compare(*c, tc.value); actual != tc.expected {
+				t.Errorf("compare(%v, %q) got %t, want %t", tc.cmp, tc.value, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestMakeRules(t *testing.T) {
+	cases := []struct {
+		name  string
+		rules []*evalpb.Rule
+		err   bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "compile regexes",
+			rules: []*evalpb.Rule{
+				{
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_REGEX, "h.l+o")),
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_UNKNOWN, "hello")),
+					},
+				},
+			},
+		},
+		{
+			name: "reject invalid regexes",
+			rules: []*evalpb.Rule{
+				{
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						fieldCmp("name", strCmp(evalpb.Comparison_OP_REGEX, "[.*")),
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, err := makeRules(tc.rules)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("makeRules() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("makeRules() failed to receive an error")
+			case len(rules) != len(tc.rules):
+				t.Errorf("makeRules() got %d rules, want %d", len(rules), len(tc.rules))
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// finishedObject is the object prow writes once a build completes.
+const finishedObject = "finished.json"
+
+// pushRequest is the body of a Pub/Sub push delivery.
+type pushRequest struct {
+	Message struct {
+		Attributes map[string]string `json:"attributes"`
+		MessageID  string            `json:"messageId"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+}
+
+// PushHandler returns a handler for Pub/Sub push deliveries of storage object notifications.
+//
+// Requests must include the token, either as the token query parameter of the push
+// endpoint url or in an Authorization: Bearer header. Rejects every request when the token is empty.
+// Sends the path of each finalized object to events.
+// Responds with 204 once the event is accepted, which acknowledges the message.
+func PushHandler(events chan<- gcs.Path, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "push notifications must POST", http.StatusMethodNotAllowed)
+			return
+		}
+		if token == "" {
+			http.Error(w, "push notifications are disabled without a token", http.StatusForbidden)
+			return
+		}
+		if !pushAuthorized(r, token) {
+			http.Error(w, "missing or invalid token", http.StatusUnauthorized)
+			return
+		}
+		var req pushRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("decode: %v", err), http.StatusBadRequest)
+			return
+		}
+		attrs := req.Message.Attributes
+		if attrs["eventType"] != "OBJECT_FINALIZE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		log := logrus.WithField("message", req.Message.MessageID)
+		if attrs["bucketId"] == "" || attrs["objectId"] == "" {
+			log.Warning("Dropping notification without an object")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		p, err := gcs.NewPath(fmt.Sprintf("gs://%s/%s", attrs["bucketId"], attrs["objectId"]))
+		if err != nil {
+			log.WithError(err).Warning("Dropping notification with a bad object")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		select {
+		case events <- *p:
+			w.WriteHeader(http.StatusNoContent)
+		case <-r.Context().Done():
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+		}
+	})
+}
+
+// pushAuthorized returns true when the request includes the token.
+func pushAuthorized(r *http.Request, token string) bool {
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(token)) == 1 {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) == 1
+}
+
+// WatchQueue sends the paths listed in queue files added to dir to events, until the context expires.
+//
+// Each file lists one object url per line and is removed once read.
+// Files are read in name order every poll interval.
+func WatchQueue(ctx context.Context, dir string, poll time.Duration, events chan<- gcs.Path) error {
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+	for {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", dir, err)
+		}
+		for _, info := range infos {
+			if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+				continue
+			}
+			name := filepath.Join(dir, info.Name())
+			if err := readQueueFile(ctx, name, events); err != nil {
+				return err
+			}
+			if err := os.Remove(name); err != nil {
+				return fmt.Errorf("remove %s: %w", name, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func readQueueFile(ctx context.Context, name string, events chan<- gcs.Path) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", name, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		p, err := gcs.NewPath(line)
+		if err != nil {
+			logrus.WithError(err).WithField("file", name).Warning("Skipping bad queue entry")
+			continue
+		}
+		select {
+		case events <- *p:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read %s: %w", name, err)
+	}
+	return nil
+}
+
+// groupIndex maps the results path of each test group to the names of its groups.
+type groupIndex map[string][]string
+
+func indexGroups(groups []*configpb.TestGroup) groupIndex {
+	idx := groupIndex{}
+	for _, tg := range groups {
+		if tg.GetResultSource().GetResultstoreConfig() != nil {
+			continue // ResultStore invocations do not write to GCS
+		}
+		p, err := groupPath(*tg)
+		if err != nil {
+			logrus.WithError(err).WithField("group", tg.Name).Warning("Cannot watch group with a bad gcs_prefix")
+			continue
+		}
+		key := p.String()
+		idx[key] = append(idx[key], tg.Name)
+	}
+	return idx
+}
+
+// groups returns the names of the groups with a build that finished at the object path.
+func (idx groupIndex) groups(p gcs.Path) []string {
+	build := strings.TrimSuffix(p.String(), "/"+finishedObject)
+	if build == p.String() {
+		return nil
+	}
+	return idx[build[:strings.LastIndex(build, "/")+1]]
+}
+
+// debouncer delays each group until some time after its first event.
+//
+// Additional events before the group is ready do not delay it further,
+// so a busy group still updates once per delay.
+type debouncer struct {
+	delay time.Duration
+	due   map[string]time.Time
+}
+
+func (d *debouncer) add(name string, now time.Time) {
+	if d.due == nil {
+		d.due = map[string]time.Time{}
+	}
+	if _, ok := d.due[name]; !ok {
+		d.due[name] = now.Add(d.delay)
+	}
+}
+
+// ready removes and returns the names of groups due by now, except for busy groups.
+func (d *debouncer) ready(now time.Time, busy func(string) bool) []string {
+	var names []string
+	for name, when := range d.due {
+		if when.After(now) || busy(name) {
+			continue
+		}
+		names = append(names, name)
+		delete(d.due, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// drainPoll is how often to retry pending groups that are still updating once events close.
+const drainPoll = 100 * time.Millisecond
+
+// UpdateOnEvents updates only the test groups with newly finished builds, until the context expires.
+//
+// A group updates after debounce passes since the first event for one of its builds.
+// Reloads the configuration every configRefresh.
+// Once events closes, updates any groups still waiting for their debounce before returning.
+func UpdateOnEvents(parent context.Context, client gcs.Client, rsClient *resultstore.Client, configPath gcs.Path, gridPrefix string, groupConcurrency, buildConcurrency int, confirm bool, groupTimeout, buildTimeout, debounce, configRefresh time.Duration, events <-chan gcs.Path) error {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	log := logrus.WithField("config", configPath)
+	cfg, err := config.ReadGCS(ctx, client, configPath)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+	idx := indexGroups(cfg.TestGroups)
+
+	var lock sync.Mutex
+	running := map[string]bool{}
+	busy := func(name string) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return running[name]
+	}
+
+	groups := make(chan configpb.TestGroup)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer close(groups)
+	for i := 0; i < groupConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tg := range groups {
+				tgp, err := testGroupPath(configPath, path.Join(gridPrefix, tg.Name))
+				if err == nil {
+					_, err = updateGroup(ctx, client, rsClient, tg, *tgp, buildConcurrency, confirm, groupTimeout, buildTimeout)
+				}
+				if err != nil {
+					log.WithField("group", tg.Name).WithError(err).Error("Error updating group")
+				}
+				lock.Lock()
+				delete(running, tg.Name)
+				lock.Unlock()
+			}
+		}()
+	}
+
+	tick := debounce / 2
+	if tick < 10*time.Millisecond {
+		tick = 10 * time.Millisecond
+	}
+	flush := time.NewTicker(tick)
+	defer flush.Stop()
+	refresh := time.NewTicker(configRefresh)
+	defer refresh.Stop()
+
+	d := debouncer{delay: debounce}
+	// dispatch sends the groups due by now to the workers.
+	dispatch := func(now time.Time) error {
+		for _, name := range d.ready(now, busy) {
+			tg := config.FindTestGroup(name, cfg)
+			if tg == nil {
+				continue
+			}
+			lock.Lock()
+			running[name] = true
+			lock.Unlock()
+			select {
+			case groups <- *tg:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case p, ok := <-events:
+			if !ok {
+				// Update the groups still waiting for their debounce,
+				// after any earlier update of the same group finishes.
+				for len(d.due) > 0 {
+					if err := dispatch(timeNow().Add(debounce)); err != nil {
+						return err
+					}
+					if len(d.due) == 0 {
+						break
+					}
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(drainPoll):
+					}
+				}
+				return nil
+			}
+			for _, name := range idx.groups(p) {
+				d.add(name, timeNow())
+			}
+		case <-refresh.C:
+			newCfg, err := config.ReadGCS(ctx, client, configPath)
+			if err != nil {
+				log.WithError(err).Warning("Failed to reload config")
+				continue
+			}
+			cfg = newCfg
+			idx = indexGroups(cfg.TestGroups)
+		case <-flush.C:
+			if err := dispatch(timeNow()); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestPushHandler(t *testing.T) {
+	const token = "secret"
+	finalized := `{"message":{"attributes":{"eventType":"OBJECT_FINALIZE","bucketId":"bucket","objectId":"logs/job/1/finished.json"},"messageId":"7"},"subscription":"sub"}`
+	cases := []struct {
+		name     string
+		method   string
+		url      string
+		header   string
+		disabled bool // without a token
+		body     string
+		code     int
+		expected []string
+	}{
+		{
+			name:   "reject get",
+			method: http.MethodGet,
+			code:   http.StatusMethodNotAllowed,
+		},
+		{
+			name:     "reject everything without a token",
+			method:   http.MethodPost,
+			url:      "/?token=",
+			disabled: true,
+			body:     finalized,
+			code:     http.StatusForbidden,
+		},
+		{
+			name:   "reject a missing token",
+			method: http.MethodPost,
+			url:    "/",
+			body:   finalized,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "reject a wrong token",
+			method: http.MethodPost,
+			url:    "/?token=wrong",
+			body:   finalized,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:     "accept a bearer token",
+			method:   http.MethodPost,
+			url:      "/",
+			header:   "Bearer " + token,
+			body:     finalized,
+			code:     http.StatusNoContent,
+			expected: []string{"gs://bucket/logs/job/1/finished.json"},
+		},
+		{
+			name:   "reject bad json",
+			method: http.MethodPost,
+			body:   "{",
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:     "send finalized objects",
+			method:   http.MethodPost,
+			body:     finalized,
+			code:     http.StatusNoContent,
+			expected: []string{"gs://bucket/logs/job/1/finished.json"},
+		},
+		{
+			name:   "acknowledge other events",
+			method: http.MethodPost,
+			body:   `{"message":{"attributes":{"eventType":"OBJECT_DELETE","bucketId":"bucket","objectId":"logs/job/1/finished.json"}}}`,
+			code:   http.StatusNoContent,
+		},
+		{
+			name:   "acknowledge missing objects",
+			method: http.MethodPost,
+			body:   `{"message":{"attributes":{"eventType":"OBJECT_FINALIZE","bucketId":"bucket"}}}`,
+			code:   http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := make(chan gcs.Path, 1)
+			w := httptest.NewRecorder()
+			url := tc.url
+			if url == "" {
+				url = "/?token=" + token
+			}
+			r := httptest.NewRequest(tc.method, url, strings.NewReader(tc.body))
+			if tc.header != "" {
+				r.Header.Set("Authorization", tc.header)
+			}
+			tok := token
+			if tc.disabled {
+				tok = ""
+			}
+			PushHandler(events, tok).ServeHTTP(w, r)
+			close(events)
+			if w.Code != tc.code {
+				t.Errorf("ServeHTTP() got code %d, want %d", w.Code, tc.code)
+			}
+			var actual []string
+			for p := range events {
+				actual = append(actual, p.String())
+			}
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("ServeHTTP() sent unexpected events (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWatchQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"b":       "gs://bucket/c/finished.json\n",
+		"a":       "gs://bucket/a/finished.json\n\nhttp://bad\ngs://bucket/b/finished.json\n",
+		".hidden": "gs://bucket/hidden/finished.json\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan gcs.Path)
+	errs := make(chan error)
+	go func() {
+		errs <- WatchQueue(ctx, dir, time.Hour, events)
+	}()
+	var actual []string
+	for i := 0; i < 3; i++ {
+		actual = append(actual, (<-events).String())
+	}
+	cancel()
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("WatchQueue() got error %v, want %v", err, context.Canceled)
+	}
+	expected := []string{
+		"gs://bucket/a/finished.json",
+		"gs://bucket/b/finished.json",
+		"gs://bucket/c/finished.json",
+	}
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("WatchQueue() sent unexpected events (-have, +want):\n%s", diff)
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read dir: %v", err)
+	}
+	var remain []string
+	for _, info := range infos {
+		remain = append(remain, info.Name())
+	}
+	if diff := cmp.Diff(remain, []string{".hidden"}); diff != "" {
+		t.Errorf("WatchQueue() left unexpected files (-have, +want):\n%s", diff)
+	}
+}
+
+func TestGroupIndex(t *testing.T) {
+	idx := indexGroups([]*configpb.TestGroup{
+		{Name: "job", GcsPrefix: "bucket/logs/job"},
+		{Name: "job-copy", GcsPrefix: "gs://bucket/logs/job/"},
+		{Name: "other", GcsPrefix: "bucket/logs/other"},
+		{Name: "local", GcsPrefix: "file:///logs/local"},
+		{Name: "bad", GcsPrefix: "http://example.com/logs/bad"},
+	})
+	cases := []struct {
+		name     string
+		path     string
+		expected []string
+	}{
+		{
+			name:     "finished builds",
+			path:     "gs://bucket/logs/job/123/finished.json",
+			expected: []string{"job", "job-copy"},
+		},
+		{
+			name:     "local builds",
+			path:     "file:///logs/local/1/finished.json",
+			expected: []string{"local"},
+		},
+		{
+			name: "ignore other objects",
+			path: "gs://bucket/logs/job/123/started.json",
+		},
+		{
+			name: "ignore nested objects",
+			path: "gs://bucket/logs/job/123/artifacts/finished.json",
+		},
+		{
+			name: "ignore unknown groups",
+			path: "gs://bucket/logs/unknown/1/finished.json",
+		},
+		{
+			name: "ignore other buckets",
+			path: "gs://elsewhere/logs/job/123/finished.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := idx.groups(newPathOrDie(tc.path))
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("groups(%s) got unexpected diff (-have, +want):\n%s", tc.path, diff)
+			}
+		})
+	}
+}
+
+func TestDebouncer(t *testing.T) {
+	now := time.Unix(1000, 0)
+	d := debouncer{delay: time.Minute}
+	idle := func(string) bool { return false }
+
+	d.add("a", now)
+	d.add("b", now.Add(30*time.Second))
+	d.add("a", now.Add(50*time.Second)) // does not delay a further
+	if actual := d.ready(now.Add(59*time.Second), idle); len(actual) != 0 {
+		t.Errorf("ready() got %v before any group is due", actual)
+	}
+	busy := func(name string) bool { return name == "a" }
+	if actual := d.ready(now.Add(time.Minute), busy); len(actual) != 0 {
+		t.Errorf("ready() got %v for a busy group", actual)
+	}
+	if diff := cmp.Diff(d.ready(now.Add(2*time.Minute), idle), []string{"a", "b"}); diff != "" {
+		t.Errorf("ready() got unexpected diff (-have, +want):\n%s", diff)
+	}
+	if actual := d.ready(now.Add(time.Hour), idle); len(actual) != 0 {
+		t.Errorf("ready() got %v after removing the ready groups", actual)
+	}
+}
+
+func TestUpdateOnEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "updater-events")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	root := newPathOrDie("file://" + filepath.ToSlash(dir) + "/")
+	client := gcs.NewLocalClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upload := func(name string, data string) {
+		if err := client.Upload(ctx, *resolveOrDie(&root, name), []byte(data), gcs.DefaultAcl, ""); err != nil {
+			t.Fatalf("Failed to upload %s: %v", name, err)
+		}
+	}
+	cfg := configpb.Configuration{
+		Dashboards: []*configpb.Dashboard{{Name: "dash"}},
+	}
+	for _, name := range []string{"hello", "idle"} {
+		cfg.TestGroups = append(cfg.TestGroups, &configpb.TestGroup{
+			Name:             name,
+			GcsPrefix:        resolveOrDie(&root, "logs/"+name).String(),
+			DaysOfResults:    7,
+			NumColumnsRecent: 6,
+		})
+		cfg.Dashboards[0].DashboardTab = append(cfg.Dashboards[0].DashboardTab, &configpb.DashboardTab{
+			Name:          name,
+			TestGroupName: name,
+		})
+	}
+	buf, err := config.MarshalBytes(&cfg)
+	if err != nil {
+		t.Fatalf("config.MarshalBytes() errored: %v", err)
+	}
+	upload("config", string(buf))
+	now := time.Now().Unix()
+	upload("logs/hello/1/started.json", jsonStarted(now).data)
+	upload("logs/hello/1/finished.json", jsonFinished(now+1, true, nil).data)
+	upload("logs/idle/1/started.json", jsonStarted(now).data)
+	upload("logs/idle/1/finished.json", jsonFinished(now+1, true, nil).data)
+
+	events := make(chan gcs.Path)
+	errs := make(chan error)
+	go func() {
+		errs <- UpdateOnEvents(ctx, client, nil, *resolveOrDie(&root, "config"), "grid", 1, 1, true, time.Minute, time.Minute, time.Millisecond, time.Hour, events)
+	}()
+	events <- *resolveOrDie(&root, "logs/hello/1/finished.json")
+
+	gridPath := *resolveOrDie(&root, "grid/hello")
+	stater := client.(gcs.Stater)
+	for {
+		if _, err := stater.Stat(ctx, gridPath); err == nil {
+			break
+		}
+		select {
+		case err := <-errs:
+			t.Fatalf("UpdateOnEvents() returned early: %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	close(events)
+	if err := <-errs; err != nil {
+		t.Errorf("UpdateOnEvents() got unexpected error: %v", err)
+	}
+	if _, err := stater.Stat(ctx, *resolveOrDie(&root, "grid/idle")); err == nil {
+		t.Error("UpdateOnEvents() updated a group without events")
+	}
+}
+
+func TestUpdateOnEventsDrain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "updater-drain")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	root := newPathOrDie("file://" + filepath.ToSlash(dir) + "/")
+	client := gcs.NewLocalClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upload := func(name string, data string) {
+		if err := client.Upload(ctx, *resolveOrDie(&root, name), []byte(data), gcs.DefaultAcl, ""); err != nil {
+			t.Fatalf("Failed to upload %s: %v", name, err)
+		}
+	}
+	cfg := configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{
+				Name:             "hello",
+				GcsPrefix:        resolveOrDie(&root, "logs/hello").String(),
+				DaysOfResults:    7,
+				NumColumnsRecent: 6,
+			},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name:         "dash",
+				DashboardTab: []*configpb.DashboardTab{{Name: "hello", TestGroupName: "hello"}},
+			},
+		},
+	}
+	buf, err := config.MarshalBytes(&cfg)
+	if err != nil {
+		t.Fatalf("config.MarshalBytes() errored: %v", err)
+	}
+	upload("config", string(buf))
+	now := time.Now().Unix()
+	upload("logs/hello/1/started.json", jsonStarted(now).data)
+	upload("logs/hello/1/finished.json", jsonFinished(now+1, true, nil).data)
+
+	// The group is still waiting for its debounce when events closes.
+	events := make(chan gcs.Path, 1)
+	events <- *resolveOrDie(&root, "logs/hello/1/finished.json")
+	close(events)
+	if err := UpdateOnEvents(ctx, client, nil, *resolveOrDie(&root, "config"), "grid", 1, 1, true, time.Minute, time.Minute, time.Hour, time.Hour, events); err != nil {
+		t.Fatalf("UpdateOnEvents() got unexpected error: %v", err)
+	}
+	if _, err := client.(gcs.Stater).Stat(ctx, *resolveOrDie(&root, "grid/hello")); err != nil {
+		t.Errorf("UpdateOnEvents() dropped the pending group: %v", err)
+	}
+}
@@ -18,10 +18,14 @@ package updater
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
 	"github.com/GoogleCloudPlatform/testgrid/metadata"
 	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
@@ -37,8 +41,8 @@ type gcsResult struct {
 }
 
 // convertResult returns an inflatedColumn representation of the GCS result.
-func convertResult(nameCfg nameConfig, id string, headers []string, result gcsResult) inflatedColumn {
-	overall := overallCell(result)
+func convertResult(nameCfg nameConfig, id string, headers []*configpb.TestGroup_ColumnHeader, result gcsResult, opt groupOptions) inflatedColumn {
+	overall := overallCell(result, opt.maxRuntime)
 	out := inflatedColumn{
 		column: &statepb.Column{
 			Build:   id,
@@ -53,49 +57,74 @@ func convertResult(nameCfg nameConfig, id string, headers []string, result gcsRe
 	version := metadata.Version(result.started.Started, result.finished.Finished)
 
 	for _, h := range headers {
-		val, ok := meta[h]
-		if !ok && h == "Commit" && version != metadata.Missing {
-			val = version
-		} else if !ok && overall.result != statuspb.TestStatus_RUNNING {
+		var val string
+		var ok bool
+		switch {
+		case h.ConfigurationValue == commitHeader:
+			if val, ok = opt.commit.override(result.started, result.finished, meta); ok {
+				break
+			}
+			val, ok = meta[h.ConfigurationValue]
+			if !ok && version != metadata.Missing {
+				val, ok = version, true
+			}
+		case h.ConfigurationValue != "":
+			val, ok = meta[h.ConfigurationValue]
+		case h.Property != "":
+			val, ok = propertyValue(h.Property, result.suites)
+		case h.Label != "":
+			val, ok = labelValue(h.Label, result.started, result.finished)
+		}
+		if !ok && overall.result != statuspb.TestStatus_RUNNING {
 			val = "missing"
 		}
 		out.column.Extra = append(out.column.Extra, val)
 	}
 
 	// Append each result into the column
-	for _, suite := range result.suites {
-		for _, r := range flattenResults(suite.Suites.Suites...) {
-			if r.Skipped != nil && *r.Skipped == "" {
-				continue
-			}
-			var c cell
-			// TODO(fejta): process properties?
-			if elapsed := r.Time; elapsed > 0 {
-				c.metrics = setElapsed(c.metrics, elapsed)
-			}
-
-			const max = 140
-			if msg := r.Message(max); msg != "" {
-				c.message = msg
+	attempts := map[string][]cell{}
+	var names []string
+	add := func(name string, c cell) {
+		switch {
+		case opt.skip && c.result == statuspb.TestStatus_PASS_WITH_SKIPS:
+			return
+		case opt.built && c.result == statuspb.TestStatus_BUILD_PASSED:
+			return
+		}
+		if opt.flaky { // Collapse repeated attempts of the same test after reading them all.
+			if _, present := attempts[name]; !present {
+				names = append(names, name)
 			}
+			attempts[name] = append(attempts[name], c)
+			return
+		}
 
-			switch {
-			case r.Failure != nil:
-				c.result = statuspb.TestStatus_FAIL
-				if c.message != "" {
-					c.icon = "F"
+		// Ensure each name is unique
+		// If we have multiple results with the same name foo
+		// then append " [n]" to the name so we wind up with:
+		//   foo
+		//   foo [1]
+		//   foo [2]
+		//   etc
+		if _, present := out.cells[name]; present && !opt.oldResults {
+			for idx := 1; true; idx++ {
+				attempt := fmt.Sprintf("%s [%d]", name, idx)
+				if _, present := out.cells[attempt]; present {
+					continue
 				}
-			case r.Skipped != nil:
-				c.result = statuspb.TestStatus_PASS_WITH_SKIPS
-				c.icon = "S"
-			default:
-				c.result = statuspb.TestStatus_PASS
+				name = attempt
+				break
 			}
+		}
+		out.cells[name] = c
+	}
 
+	for _, suite := range result.suites {
+		rowName := func(testName string) string {
 			parsed := make([]interface{}, len(nameCfg.parts))
 			for i, p := range nameCfg.parts {
 				if p == "Tests name" {
-					parsed[i] = r.Name
+					parsed[i] = testName
 					continue
 				}
 				v, present := suite.Metadata[p]
@@ -105,28 +134,38 @@ func convertResult(nameCfg nameConfig, id string, headers []string, result gcsRe
 				}
 				parsed[i] = meta[p]
 			}
-			name := fmt.Sprintf(nameCfg.format, parsed...)
-
-			// Ensure each name is unique
-			// If we have multiple results with the same name foo
-			// then append " [n]" to the name so we wind up with:
-			//   foo
-			//   foo [1]
-			//   foo [2]
-			//   etc
-			if _, present := out.cells[name]; present {
-				for idx := 1; true; idx++ {
-					attempt := fmt.Sprintf("%s [%d]", name, idx)
-					if _, present := out.cells[attempt]; present {
+			return fmt.Sprintf(nameCfg.format, parsed...)
+		}
+
+		for _, t := range flattenTargets(suite.Suites.Suites...) {
+			if !opt.methods.enabled || t.name == "" { // Nothing to group the methods by.
+				for _, r := range t.results {
+					if r.Skipped != nil && *r.Skipped == "" {
 						continue
 					}
-					name = attempt
-					break
+					add(rowName(dotName(t.name, r.Name)), t.resultCell(r, opt))
+				}
+				continue
+			}
+			methods, cells := t.methods(opt)
+			if len(cells) == 0 {
+				continue
+			}
+			target := rowName(t.name)
+			add(target, targetCell(cells, opt.flaky))
+			if n := opt.methods.max; n > 0 && len(methods) > n {
+				continue
+			}
+			for _, m := range methods {
+				for _, c := range cells[m] {
+					add(target+methodSeparator+m, c)
 				}
 			}
-			out.cells[name] = c
 		}
 	}
+	for _, name := range names {
+		out.cells[name] = collapseAttempts(attempts[name])
+	}
 
 	if overall.result == statuspb.TestStatus_FAIL && overall.message == "" { // Ensure failing build has a failing cell and/or overall message
 		var found bool
@@ -147,11 +186,118 @@ func convertResult(nameCfg nameConfig, id string, headers []string, result gcsRe
 		}
 	}
 
+	if opt.shortText != "" { // Show the metric in place of any other icon
+		for name, c := range out.cells {
+			out.cells[name] = setShortText(c, opt.shortText)
+		}
+	}
+
 	return out
 }
 
+// resultCell returns the cell for a junit result.
+func resultCell(r junit.Result, opt groupOptions) cell {
+	var c cell
+	if elapsed := r.Time; elapsed > 0 {
+		c.metrics = setElapsed(c.metrics, elapsed)
+	}
+	c.metrics = opt.metrics.addMetrics(c.metrics, r.Properties)
+
+	const max = 140
+	if msg := r.Message(max); msg != "" {
+		c.message = msg
+	}
+
+	switch {
+	case r.Failure != nil:
+		c.result = statuspb.TestStatus_FAIL
+		if c.message != "" {
+			c.icon = "F"
+		}
+	case r.Skipped != nil:
+		c.result = statuspb.TestStatus_PASS_WITH_SKIPS
+		c.icon = "S"
+	default:
+		c.result = statuspb.TestStatus_PASS
+	}
+
+	if status := customStatus(opt.rules, r); status != nil {
+		c.result = *status
+	}
+	if name := opt.userProperty; name != "" {
+		c.userProperty = userPropertyValue(name, r.Properties)
+	}
+	return annotateCell(c, r, opt.annotations)
+}
+
+// annotateCell sets the icon of the first annotation with a property present on the result.
+//
+// Adds the property value to the message.
+func annotateCell(c cell, r junit.Result, annotations []*configpb.TestGroup_TestAnnotation) cell {
+	if r.Properties == nil {
+		return c
+	}
+	for _, a := range annotations {
+		name := a.GetPropertyName()
+		if name == "" {
+			continue
+		}
+		for _, p := range r.Properties.PropertyList {
+			if p.Name != name {
+				continue
+			}
+			c.icon = a.ShortText
+			msg := name
+			if p.Value != "" {
+				msg = fmt.Sprintf("%s: %s", name, p.Value)
+			}
+			if c.message != "" {
+				msg = c.message + "; " + msg
+			}
+			c.message = msg
+			return c
+		}
+	}
+	return c
+}
+
+// collapseAttempts combines repeated attempts of a test into a single cell.
+//
+// Attempts that both pass and fail are FLAKY, with a message listing the outcome of each attempt.
+// Otherwise the final attempt wins.
+func collapseAttempts(attempts []cell) cell {
+	last := attempts[len(attempts)-1]
+	if len(attempts) == 1 {
+		return last
+	}
+	var passes, fails int
+	outcomes := make([]string, 0, len(attempts))
+	for _, c := range attempts {
+		switch {
+		case result.IsPassingResult(c.result):
+			passes++
+		case result.IsFailingResult(c.result):
+			fails++
+		}
+		outcomes = append(outcomes, c.result.String())
+	}
+	if passes == 0 || fails == 0 {
+		return last
+	}
+	return cell{
+		result:  statuspb.TestStatus_FLAKY,
+		message: fmt.Sprintf("Failed %d of %d attempts: %s", fails, len(attempts), strings.Join(outcomes, ", ")),
+		metrics: last.metrics,
+	}
+}
+
 // overallCell generates the overall cell for this GCS result.
-func overallCell(result gcsResult) cell {
+//
+// Unfinished builds running longer than maxRuntime (or else a day) have TIMED_OUT.
+func overallCell(result gcsResult, maxRuntime time.Duration) cell {
+	if maxRuntime <= 0 {
+		maxRuntime = defaultMaxRuntime
+	}
 	var c cell
 	var finished int64
 	if result.finished.Timestamp != nil {
@@ -165,9 +311,9 @@ func overallCell(result gcsResult) cell {
 			c.result = statuspb.TestStatus_FAIL
 		}
 		c.metrics = setElapsed(nil, float64(finished-result.started.Timestamp))
-	case time.Now().Add(-24*time.Hour).Unix() > result.started.Timestamp:
-		c.result = statuspb.TestStatus_FAIL
-		c.message = "Build did not complete within 24 hours"
+	case time.Now().Add(-maxRuntime).Unix() > result.started.Timestamp:
+		c.result = statuspb.TestStatus_TIMED_OUT
+		c.message = fmt.Sprintf("Build did not complete within %d hours", int(maxRuntime.Hours()))
 		c.icon = "T"
 	default:
 		c.result = statuspb.TestStatus_RUNNING
@@ -177,6 +323,69 @@ func overallCell(result gcsResult) cell {
 	return c
 }
 
+// propertyValue returns the value of the named junit property in any suite or case.
+//
+// Conflicting values are sorted and joined with a comma.
+func propertyValue(name string, suites []gcs.SuitesMeta) (string, bool) {
+	values := map[string]bool{}
+	var walk func(...junit.Suite)
+	walk = func(suites ...junit.Suite) {
+		for _, suite := range suites {
+			addProperties(values, name, suite.Properties)
+			for _, r := range suite.Results {
+				addProperties(values, name, r.Properties)
+			}
+			walk(suite.Suites...)
+		}
+	}
+	for _, suite := range suites {
+		walk(suite.Suites.Suites...)
+	}
+	if len(values) == 0 {
+		return "", false
+	}
+	vals := make([]string, 0, len(values))
+	for v := range values {
+		vals = append(vals, v)
+	}
+	sort.Strings(vals)
+	return strings.Join(vals, ","), true
+}
+
+// addProperties adds the value of every property with this name.
+func addProperties(values map[string]bool, name string, props *junit.Properties) {
+	if props == nil {
+		return
+	}
+	for _, p := range props.PropertyList {
+		if p.Name == name {
+			values[p.Value] = true
+		}
+	}
+}
+
+// userPropertyValue returns the first value of the named property.
+func userPropertyValue(name string, props *junit.Properties) string {
+	if props == nil {
+		return ""
+	}
+	for _, p := range props.PropertyList {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// labelValue returns the named label, preferring finished.json to started.json.
+func labelValue(name string, started gcs.Started, finished gcs.Finished) (string, bool) {
+	if val, ok := finished.Labels[name]; ok {
+		return val, true
+	}
+	val, ok := started.Labels[name]
+	return val, ok
+}
+
 const elapsedKey = "test-duration-minutes"
 
 // setElapsed inserts the seconds-elapsed metric.
@@ -188,22 +397,6 @@ func setElapsed(metrics map[string]float64, seconds float64) map[string]float64
 	return metrics
 }
 
-// flattenResults returns the DFS of all junit results in all suites.
-func flattenResults(suites ...junit.Suite) []junit.Result {
-	var results []junit.Result
-	for _, suite := range suites {
-		for _, innerSuite := range suite.Suites {
-			innerSuite.Name = dotName(suite.Name, innerSuite.Name)
-			results = append(results, flattenResults(innerSuite)...)
-		}
-		for _, r := range suite.Results {
-			r.Name = dotName(suite.Name, r.Name)
-			results = append(results, r)
-		}
-	}
-	return results
-}
-
 // dotName returns left.right or left or right
 func dotName(left, right string) string {
 	if left != "" && right != "" {
@@ -23,11 +23,74 @@ import (
 
 	"github.com/GoogleCloudPlatform/testgrid/metadata"
 	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 )
 
+// testNames names each row after its test name alone.
+var testNames = nameConfig{
+	format: "%s",
+	parts:  []string{"Tests name"},
+}
+
+// finishedAt returns a build that started and finished at the specified times.
+func finishedAt(started, finished int64, passed bool) gcsResult {
+	return gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{
+				Timestamp: started,
+			},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Timestamp: &finished,
+				Passed:    &passed,
+			},
+		},
+	}
+}
+
+// suitesWith returns the results in a single unnamed junit suite.
+func suitesWith(results ...junit.Result) []gcs.SuitesMeta {
+	return []gcs.SuitesMeta{
+		{
+			Suites: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Results: results,
+					},
+				},
+			},
+		},
+	}
+}
+
+// props returns junit properties from alternating names and values.
+func props(kv ...string) *junit.Properties {
+	var p junit.Properties
+	for i := 0; i < len(kv); i += 2 {
+		p.PropertyList = append(p.PropertyList, junit.Property{Name: kv[i], Value: kv[i+1]})
+	}
+	return &p
+}
+
+// passedColumn returns the column of a build that started at started and passed a second later.
+func passedColumn(started int64, cells map[string]cell) inflatedColumn {
+	cells["Overall"] = cell{
+		result:  statuspb.TestStatus_PASS,
+		metrics: setElapsed(nil, 1),
+	}
+	return inflatedColumn{
+		column: &statepb.Column{
+			Started: float64(started * 1000),
+		},
+		cells: cells,
+	}
+}
+
 func TestConvertResult(t *testing.T) {
 	pint := func(v int64) *int64 {
 		return &v
@@ -41,7 +104,7 @@ func TestConvertResult(t *testing.T) {
 		name     string
 		nameCfg  nameConfig
 		id       string
-		headers  []string
+		headers  []*configpb.TestGroup_ColumnHeader
 		result   gcsResult
 		expected inflatedColumn
 	}{
@@ -51,7 +114,7 @@ func TestConvertResult(t *testing.T) {
 				column: &statepb.Column{},
 				cells: map[string]cell{
 					"Overall": {
-						result:  statuspb.TestStatus_FAIL,
+						result:  statuspb.TestStatus_TIMED_OUT,
 						icon:    "T",
 						message: "Build did not complete within 24 hours",
 					},
@@ -59,9 +122,14 @@ func TestConvertResult(t *testing.T) {
 			},
 		},
 		{
-			name:    "correct column information",
-			headers: []string{"Commit", "hello", "spam", "do not have this one"},
-			id:      "hello",
+			name: "correct column information",
+			headers: []*configpb.TestGroup_ColumnHeader{
+				{ConfigurationValue: "Commit"},
+				{ConfigurationValue: "hello"},
+				{ConfigurationValue: "spam"},
+				{ConfigurationValue: "do not have this one"},
+			},
+			id: "hello",
 			result: gcsResult{
 				started: gcs.Started{
 					Started: metadata.Started{
@@ -91,7 +159,7 @@ func TestConvertResult(t *testing.T) {
 				},
 				cells: map[string]cell{
 					"Overall": {
-						result:  statuspb.TestStatus_FAIL,
+						result:  statuspb.TestStatus_TIMED_OUT,
 						icon:    "T",
 						message: "Build did not complete within 24 hours",
 					},
@@ -99,9 +167,14 @@ func TestConvertResult(t *testing.T) {
 			},
 		},
 		{
-			name:    "running results do not have missing column headers",
-			headers: []string{"Commit", "hello", "spam", "do not have this one"},
-			id:      "hello",
+			name: "running results do not have missing column headers",
+			headers: []*configpb.TestGroup_ColumnHeader{
+				{ConfigurationValue: "Commit"},
+				{ConfigurationValue: "hello"},
+				{ConfigurationValue: "spam"},
+				{ConfigurationValue: "do not have this one"},
+			},
+			id: "hello",
 			result: gcsResult{
 				started: gcs.Started{
 					Started: metadata.Started{
@@ -455,7 +528,7 @@ func TestConvertResult(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := convertResult(tc.nameCfg, tc.id, tc.headers, tc.result)
+			actual := convertResult(tc.nameCfg, tc.id, tc.headers, tc.result, groupOptions{})
 			if !reflect.DeepEqual(actual, tc.expected) {
 				t.Errorf(
 					"convertResult(%v, %v,%v, %v) got %v, want %v",
@@ -471,6 +544,399 @@ func TestConvertResult(t *testing.T) {
 	}
 }
 
+func TestColumnHeaders(t *testing.T) {
+	headers := []*configpb.TestGroup_ColumnHeader{
+		{Property: "suite-prop"},
+		{Property: "case-prop"},
+		{Property: "conflict"},
+		{Property: "not a property"},
+		{Label: "started-label"},
+		{Label: "both-label"},
+		{Label: "not a label"},
+	}
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{
+				Timestamp: 300,
+				Labels: map[string]string{
+					"started-label": "from started",
+					"both-label":    "overridden",
+				},
+			},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Labels: map[string]string{
+					"both-label": "from finished",
+				},
+			},
+		},
+		suites: []gcs.SuitesMeta{
+			{
+				Suites: junit.Suites{
+					Suites: []junit.Suite{
+						{
+							Properties: props("suite-prop", "suite value", "conflict", "zebra"),
+							Suites: []junit.Suite{
+								{
+									Properties: props("conflict", "apple"),
+								},
+							},
+						},
+					},
+				},
+			},
+			suitesWith(junit.Result{
+				Name:       "case",
+				Properties: props("case-prop", "case value", "conflict", "zebra"),
+			})[0],
+		},
+	}
+	expected := inflatedColumn{
+		column: &statepb.Column{
+			Build:   "hello",
+			Started: 300 * 1000,
+			Extra: []string{
+				"suite value",
+				"case value",
+				"apple,zebra",
+				"missing",
+				"from started",
+				"from finished",
+				"missing",
+			},
+		},
+		cells: map[string]cell{
+			"Overall": {
+				result:  statuspb.TestStatus_TIMED_OUT,
+				icon:    "T",
+				message: "Build did not complete within 24 hours",
+			},
+			"case": {
+				result: statuspb.TestStatus_PASS,
+			},
+		},
+	}
+
+	if actual := convertResult(testNames, "hello", headers, result, groupOptions{}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("convertResult() got %v, want %v", actual, expected)
+	}
+}
+
+func TestCollapseAttempts(t *testing.T) {
+	pass := cell{result: statuspb.TestStatus_PASS, metrics: setElapsed(nil, 60)}
+	fail := cell{result: statuspb.TestStatus_FAIL, icon: "F", message: "boom"}
+	cases := []struct {
+		name     string
+		attempts []cell
+		expected cell
+	}{
+		{
+			name:     "single attempt",
+			attempts: []cell{fail},
+			expected: fail,
+		},
+		{
+			name:     "all pass",
+			attempts: []cell{{result: statuspb.TestStatus_PASS_WITH_SKIPS}, pass},
+			expected: pass,
+		},
+		{
+			name:     "all fail",
+			attempts: []cell{{result: statuspb.TestStatus_TIMED_OUT}, fail},
+			expected: fail,
+		},
+		{
+			name:     "pass then fail",
+			attempts: []cell{pass, fail},
+			expected: cell{
+				result:  statuspb.TestStatus_FLAKY,
+				message: "Failed 1 of 2 attempts: PASS, FAIL",
+			},
+		},
+		{
+			name:     "ignore other outcomes",
+			attempts: []cell{fail, {result: statuspb.TestStatus_RUNNING}, fail, pass},
+			expected: cell{
+				result:  statuspb.TestStatus_FLAKY,
+				message: "Failed 2 of 4 attempts: FAIL, RUNNING, FAIL, PASS",
+				metrics: setElapsed(nil, 60),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := collapseAttempts(tc.attempts); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("collapseAttempts() got %#v, want %#v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFlakyStatus(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	now := time.Now().Unix()
+	result := finishedAt(now, now+1, true)
+	result.suites = suitesWith(
+		junit.Result{
+			Name:    "retried",
+			Failure: pstr("boom"),
+			Time:    1,
+		},
+		junit.Result{
+			Name: "retried",
+			Time: 2,
+		},
+		junit.Result{
+			Name:    "always fails",
+			Failure: pstr("first"),
+		},
+		junit.Result{
+			Name:    "always fails",
+			Failure: pstr("second"),
+		},
+		junit.Result{
+			Name: "once",
+		},
+	)
+	expected := passedColumn(now, map[string]cell{
+		"retried": {
+			result:  statuspb.TestStatus_FLAKY,
+			message: "Failed 1 of 2 attempts: FAIL, PASS",
+			metrics: setElapsed(nil, 2),
+		},
+		"always fails": {
+			result:  statuspb.TestStatus_FAIL,
+			icon:    "F",
+			message: "second",
+		},
+		"once": {
+			result: statuspb.TestStatus_PASS,
+		},
+	})
+
+	if actual := convertResult(testNames, "", nil, result, groupOptions{flaky: true}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("convertResult() got %v, want %v", actual, expected)
+	}
+}
+
+func TestIgnoreResults(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	now := time.Now().Unix()
+	result := finishedAt(now, now+1, true)
+	result.suites = suitesWith(
+		junit.Result{
+			Name:    "skipped",
+			Skipped: pstr("not today"),
+		},
+		junit.Result{
+			Name:       "built",
+			Properties: props("built", "only"),
+		},
+		junit.Result{
+			Name:    "rerun",
+			Failure: pstr("old"),
+		},
+		junit.Result{
+			Name: "rerun",
+			Time: 2,
+		},
+	)
+	rules := mustMakeRules(
+		&evalpb.Rule{
+			ComputedStatus: statuspb.TestStatus_BUILD_PASSED,
+			TestResultComparisons: []*evalpb.TestResultComparison{
+				propertyCmp("built", strCmp(evalpb.Comparison_OP_EQ, "only")),
+			},
+		},
+	)
+	skipped := cell{
+		result:  statuspb.TestStatus_PASS_WITH_SKIPS,
+		icon:    "S",
+		message: "not today",
+	}
+	built := cell{
+		result: statuspb.TestStatus_BUILD_PASSED,
+	}
+	oldRerun := cell{
+		result:  statuspb.TestStatus_FAIL,
+		icon:    "F",
+		message: "old",
+	}
+	rerun := cell{
+		result:  statuspb.TestStatus_PASS,
+		metrics: setElapsed(nil, 2),
+	}
+	cases := []struct {
+		name     string
+		opt      groupOptions
+		expected map[string]cell
+	}{
+		{
+			name: "show skipped, built and old results by default",
+			opt: groupOptions{
+				rules: rules,
+			},
+			expected: map[string]cell{
+				"skipped":   skipped,
+				"built":     built,
+				"rerun":     oldRerun,
+				"rerun [1]": rerun,
+			},
+		},
+		{
+			name: "ignore skipped results",
+			opt: groupOptions{
+				rules: rules,
+				skip:  true,
+			},
+			expected: map[string]cell{
+				"built":     built,
+				"rerun":     oldRerun,
+				"rerun [1]": rerun,
+			},
+		},
+		{
+			name: "ignore built results",
+			opt: groupOptions{
+				rules: rules,
+				built: true,
+			},
+			expected: map[string]cell{
+				"skipped":   skipped,
+				"rerun":     oldRerun,
+				"rerun [1]": rerun,
+			},
+		},
+		{
+			name: "ignore old results",
+			opt: groupOptions{
+				rules:      rules,
+				oldResults: true,
+			},
+			expected: map[string]cell{
+				"skipped": skipped,
+				"built":   built,
+				"rerun":   rerun,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := passedColumn(now, tc.expected)
+			if actual := convertResult(testNames, "", nil, result, tc.opt); !reflect.DeepEqual(actual, expected) {
+				t.Errorf("convertResult() got %v, want %v", actual, expected)
+			}
+		})
+	}
+}
+
+func TestUserProperty(t *testing.T) {
+	now := time.Now().Unix()
+	result := finishedAt(now, now+1, true)
+	result.suites = suitesWith(
+		junit.Result{
+			Name:       "sharded",
+			Properties: props("node", "node-1", "node", "node-2"),
+		},
+		junit.Result{
+			Name: "unknown",
+		},
+	)
+	expected := passedColumn(now, map[string]cell{
+		"sharded": {
+			result:       statuspb.TestStatus_PASS,
+			userProperty: "node-1",
+		},
+		"unknown": {
+			result: statuspb.TestStatus_PASS,
+		},
+	})
+
+	if actual := convertResult(testNames, "", nil, result, groupOptions{userProperty: "node"}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("convertResult() got %v, want %v", actual, expected)
+	}
+}
+
+func TestAnnotateCell(t *testing.T) {
+	annotation := func(property, text string) *configpb.TestGroup_TestAnnotation {
+		return &configpb.TestGroup_TestAnnotation{
+			ShortText: text,
+			ShortTextMessageSource: &configpb.TestGroup_TestAnnotation_PropertyName{
+				PropertyName: property,
+			},
+		}
+	}
+	annotations := []*configpb.TestGroup_TestAnnotation{
+		annotation("quarantined", "Q"),
+		annotation("retried", "RT"),
+	}
+	pass := cell{result: statuspb.TestStatus_PASS}
+	fail := cell{result: statuspb.TestStatus_FAIL, icon: "F", message: "boom"}
+	cases := []struct {
+		name        string
+		cell        cell
+		result      junit.Result
+		annotations []*configpb.TestGroup_TestAnnotation
+		expected    cell
+	}{
+		{
+			name:     "basically works",
+			cell:     pass,
+			expected: pass,
+		},
+		{
+			name:     "no annotations",
+			cell:     pass,
+			result:   junit.Result{Properties: props("quarantined", "yes")},
+			expected: pass,
+		},
+		{
+			name:        "missing property",
+			cell:        fail,
+			result:      junit.Result{Properties: props("owner", "me")},
+			annotations: annotations,
+			expected:    fail,
+		},
+		{
+			name:        "annotate",
+			cell:        pass,
+			result:      junit.Result{Properties: props("retried", "3")},
+			annotations: annotations,
+			expected: cell{
+				result:  statuspb.TestStatus_PASS,
+				icon:    "RT",
+				message: "retried: 3",
+			},
+		},
+		{
+			name:        "first annotation wins",
+			cell:        fail,
+			result:      junit.Result{Properties: props("retried", "3", "quarantined", "")},
+			annotations: annotations,
+			expected: cell{
+				result:  statuspb.TestStatus_FAIL,
+				icon:    "Q",
+				message: "boom; quarantined",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := annotateCell(tc.cell, tc.result, tc.annotations); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("annotateCell() got %#v, want %#v", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func TestOverallCell(t *testing.T) {
 	pint := func(v int64) *int64 {
 		return &v
@@ -478,9 +944,10 @@ func TestOverallCell(t *testing.T) {
 	yes := true
 	var no bool
 	cases := []struct {
-		name     string
-		result   gcsResult
-		expected cell
+		name       string
+		result     gcsResult
+		maxRuntime time.Duration
+		expected   cell
 	}{
 		{
 			name: "result timed out",
@@ -492,11 +959,43 @@ func TestOverallCell(t *testing.T) {
 				},
 			},
 			expected: cell{
-				result:  statuspb.TestStatus_FAIL,
+				result:  statuspb.TestStatus_TIMED_OUT,
 				message: "Build did not complete within 24 hours",
 				icon:    "T",
 			},
 		},
+		{
+			name: "result timed out sooner",
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: time.Now().Add(-3 * time.Hour).Unix(),
+					},
+				},
+			},
+			maxRuntime: 2 * time.Hour,
+			expected: cell{
+				result:  statuspb.TestStatus_TIMED_OUT,
+				message: "Build did not complete within 2 hours",
+				icon:    "T",
+			},
+		},
+		{
+			name: "result still running",
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: time.Now().Add(-25 * time.Hour).Unix(),
+					},
+				},
+			},
+			maxRuntime: 48 * time.Hour,
+			expected: cell{
+				result:  statuspb.TestStatus_RUNNING,
+				message: "Build still running...",
+				icon:    "R",
+			},
+		},
 		{
 			name: "passed result passes",
 			result: gcsResult{
@@ -560,9 +1059,9 @@ func TestOverallCell(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := overallCell(tc.result)
+			actual := overallCell(tc.result, tc.maxRuntime)
 			if !reflect.DeepEqual(actual, tc.expected) {
-				t.Errorf("overallCell(%v) got %v, want %v", tc.result, actual, tc.expected)
+				t.Errorf("overallCell(%v, %v) got %v, want %v", tc.result, tc.maxRuntime, actual, tc.expected)
 			}
 		})
 	}
@@ -615,119 +1114,6 @@ func TestSetElapsed(t *testing.T) {
 	}
 }
 
-func TestFlattenResults(t *testing.T) {
-	pstr := func(s string) *string {
-		return &s
-	}
-	cases := []struct {
-		name     string
-		suites   []junit.Suite
-		expected []junit.Result
-	}{
-		{
-			name: "basically works",
-		},
-		{
-			name: "results from multiple suites",
-			suites: []junit.Suite{
-				{
-					Name: "suite1",
-					Results: []junit.Result{
-						{
-							Name:   "resultA",
-							Output: pstr("hello"),
-						},
-						{
-							Name:  "resultB",
-							Error: pstr("bonk"),
-						},
-					},
-				},
-				{
-					Name: "suite-two",
-					Results: []junit.Result{
-						{
-							Name: "resultX",
-						},
-					},
-				},
-			},
-			expected: []junit.Result{
-				{
-					Name:   "suite1.resultA",
-					Output: pstr("hello"),
-				},
-				{
-					Name:  "suite1.resultB",
-					Error: pstr("bonk"),
-				},
-				{
-					Name: "suite-two.resultX",
-				},
-			},
-		},
-		{
-			name: "find results deeply nested in suites",
-			suites: []junit.Suite{
-				{
-					Name: "must",
-					Suites: []junit.Suite{
-						{
-							Name: "go",
-							Suites: []junit.Suite{
-								{
-									Name: "deeper",
-									Results: []junit.Result{
-										{
-											Name:    "leaf",
-											Skipped: pstr("first"),
-										},
-									},
-								},
-							},
-							Results: []junit.Result{
-								{
-									Name:    "branch",
-									Skipped: pstr("second"),
-								},
-							},
-						},
-					},
-					Results: []junit.Result{
-						{
-							Name:    "trunk",
-							Skipped: pstr("third"),
-						},
-					},
-				},
-			},
-			expected: []junit.Result{
-				{
-					Name:    "must.go.deeper.leaf",
-					Skipped: pstr("first"),
-				},
-				{
-					Name:    "must.go.branch",
-					Skipped: pstr("second"),
-				},
-				{
-					Name:    "must.trunk",
-					Skipped: pstr("third"),
-				},
-			},
-		},
-	}
-
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			actual := flattenResults(tc.suites...)
-			if !reflect.DeepEqual(actual, tc.expected) {
-				t.Errorf("flattenResults(%v) got %v, want %v", tc.suites, actual, tc.expected)
-			}
-		})
-	}
-}
-
 func TestDotName(t *testing.T) {
 	cases := []struct {
 		name     string
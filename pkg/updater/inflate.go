@@ -40,8 +40,9 @@ type cell struct {
 
 	cellID string
 
-	icon    string
-	message string
+	icon         string
+	message      string
+	userProperty string
 
 	metrics map[string]float64
 }
@@ -123,6 +124,9 @@ func inflateRow(parent context.Context, row *statepb.Row) <-chan cell {
 			if result != statuspb.TestStatus_NO_RESULT {
 				c.icon = row.Icons[filledIdx]
 				c.message = row.Messages[filledIdx]
+				if filledIdx < len(row.UserProperty) {
+					c.userProperty = row.UserProperty[filledIdx]
+				}
 				filledIdx++
 			}
 			select {
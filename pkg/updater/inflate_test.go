@@ -357,6 +357,33 @@ func TestInflateRow(t *testing.T) {
 		{
 			name: "basically works",
 		},
+		{
+			name: "preserve user properties",
+			row: statepb.Row{
+				CellIds:      blank(3),
+				Icons:        blank(2),
+				Messages:     blank(2),
+				UserProperty: []string{"node-1", "node-2"},
+				Results: []int32{
+					int32(statuspb.TestStatus_PASS), 1,
+					int32(statuspb.TestStatus_NO_RESULT), 1,
+					int32(statuspb.TestStatus_FAIL), 1,
+				},
+			},
+			expected: []cell{
+				{
+					result:       statuspb.TestStatus_PASS,
+					userProperty: "node-1",
+				},
+				{
+					result: statuspb.TestStatus_NO_RESULT,
+				},
+				{
+					result:       statuspb.TestStatus_FAIL,
+					userProperty: "node-2",
+				},
+			},
+		},
 		{
 			name: "preserve cell ids",
 			row: statepb.Row{
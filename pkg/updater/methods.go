@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+// methodSeparator joins the target and method names of a test method row.
+//
+// The summarizer ignores rows with this separator.
+const methodSeparator = "@TESTGRID@"
+
+// methodOptions configures how test methods become rows.
+type methodOptions struct {
+	enabled    bool
+	match      *regexp.Regexp    // only methods with a matching name, if set
+	full       bool              // prefix method names with their class
+	max        int               // omit the methods of targets with more methods, if positive
+	properties map[string]string // only methods with all these properties
+}
+
+func makeMethodOptions(tg configpb.TestGroup) (methodOptions, error) {
+	opt := methodOptions{
+		enabled: tg.EnableTestMethods,
+		full:    tg.UseFullMethodNames,
+		max:     int(tg.MaxTestMethodsPerTest),
+	}
+	if !opt.enabled {
+		return opt, nil
+	}
+	if expr := tg.TestMethodMatchRegex; expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return opt, fmt.Errorf("test_method_match_regex: %w", err)
+		}
+		opt.match = re
+	}
+	if len(tg.TestMethodProperties) > 0 {
+		opt.properties = map[string]string{}
+		for _, kv := range tg.TestMethodProperties {
+			opt.properties[kv.Key] = kv.Value
+		}
+	}
+	return opt, nil
+}
+
+// include returns true when the method should have a row.
+func (o methodOptions) include(name string, r junit.Result) bool {
+	if o.match != nil && !o.match.MatchString(name) {
+		return false
+	}
+	for key, want := range o.properties {
+		values := map[string]bool{}
+		addProperties(values, key, r.Properties)
+		if !values[want] {
+			return false
+		}
+	}
+	return true
+}
+
+// target holds the test methods of a junit suite.
+type target struct {
+	name       string
+	results    []junit.Result
+	properties []junit.Property // of the suite and the suites containing it, outermost first
+}
+
+// flattenTargets returns the DFS of all junit suites with results.
+//
+// Nested suite names are joined with dots.
+func flattenTargets(suites ...junit.Suite) []target {
+	var targets []target
+	for _, suite := range suites {
+		var props []junit.Property
+		if suite.Properties != nil {
+			props = suite.Properties.PropertyList
+		}
+		for _, innerSuite := range suite.Suites {
+			innerSuite.Name = dotName(suite.Name, innerSuite.Name)
+			if len(props) > 0 {
+				inherited := append([]junit.Property{}, props...)
+				if innerSuite.Properties != nil {
+					inherited = append(inherited, innerSuite.Properties.PropertyList...)
+				}
+				innerSuite.Properties = &junit.Properties{PropertyList: inherited}
+			}
+			targets = append(targets, flattenTargets(innerSuite)...)
+		}
+		if len(suite.Results) > 0 {
+			targets = append(targets, target{name: suite.Name, results: suite.Results, properties: props})
+		}
+	}
+	return targets
+}
+
+// resultCell returns the cell for a result of the target.
+//
+// Includes the metrics of the suites when configured to, without
+// replacing the metrics of the result itself.
+func (t target) resultCell(r junit.Result, opt groupOptions) cell {
+	c := resultCell(r, opt)
+	if opt.metrics.suites {
+		c.metrics = opt.metrics.inheritMetrics(c.metrics, t.properties)
+	}
+	return c
+}
+
+// methodName returns the display name of the result.
+func (t target) methodName(r junit.Result, full bool) string {
+	if !full {
+		return r.Name
+	}
+	if r.ClassName != "" {
+		return dotName(r.ClassName, r.Name)
+	}
+	return dotName(t.name, r.Name)
+}
+
+// methods returns the names of the methods to include, in order, and the cells of every attempt of each method.
+func (t target) methods(opt groupOptions) ([]string, map[string][]cell) {
+	var names []string
+	cells := map[string][]cell{}
+	included := map[string]bool{}
+	for _, r := range t.results {
+		if r.Skipped != nil && *r.Skipped == "" {
+			continue
+		}
+		name := t.methodName(r, opt.methods.full)
+		cells[name] = append(cells[name], t.resultCell(r, opt))
+		if !included[name] && opt.methods.include(name, r) {
+			included[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, cells
+}
+
+// targetCell summarizes the results of every method of a target.
+//
+// The target fails when any method fails.
+func targetCell(cells map[string][]cell, flaky bool) cell {
+	var failed, flakes, skips int
+	var minutes float64
+	for _, attempts := range cells {
+		for _, c := range attempts {
+			minutes += c.metrics[elapsedKey]
+		}
+		c := methodCell(attempts, flaky)
+		switch {
+		case result.IsFailingResult(c.result):
+			failed++
+		case c.result == statuspb.TestStatus_FLAKY:
+			flakes++
+		case c.result == statuspb.TestStatus_PASS_WITH_SKIPS:
+			skips++
+		}
+	}
+	c := cell{result: statuspb.TestStatus_PASS}
+	switch {
+	case failed > 0:
+		c.result = statuspb.TestStatus_FAIL
+		c.icon = "F"
+		c.message = fmt.Sprintf("%d of %d methods failed", failed, len(cells))
+	case flakes > 0:
+		c.result = statuspb.TestStatus_FLAKY
+		c.message = fmt.Sprintf("%d of %d methods flaked", flakes, len(cells))
+	case skips > 0:
+		c.result = statuspb.TestStatus_PASS_WITH_SKIPS
+	}
+	if minutes > 0 {
+		c.metrics = setElapsed(nil, minutes*60)
+	}
+	return c
+}
+
+// methodCell returns the outcome of a method across its attempts.
+//
+// Collapses the attempts when flaky, otherwise any failing attempt fails the method.
+func methodCell(attempts []cell, flaky bool) cell {
+	if flaky {
+		return collapseAttempts(attempts)
+	}
+	for _, c := range attempts {
+		if result.IsFailingResult(c.result) {
+			return c
+		}
+	}
+	return attempts[len(attempts)-1]
+}
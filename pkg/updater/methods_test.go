@@ -0,0 +1,470 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestMakeMethodOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		group    configpb.TestGroup
+		expected methodOptions
+		err      bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "ignore settings when disabled",
+			group: configpb.TestGroup{
+				TestMethodMatchRegex: "(",
+				UseFullMethodNames:   true,
+			},
+			expected: methodOptions{
+				full: true,
+			},
+		},
+		{
+			name: "enabled",
+			group: configpb.TestGroup{
+				EnableTestMethods:     true,
+				TestMethodMatchRegex:  "^Test",
+				UseFullMethodNames:    true,
+				MaxTestMethodsPerTest: 10,
+				TestMethodProperties: []*configpb.TestGroup_KeyValue{
+					{Key: "size", Value: "small"},
+				},
+			},
+			expected: methodOptions{
+				enabled:    true,
+				full:       true,
+				max:        10,
+				properties: map[string]string{"size": "small"},
+			},
+		},
+		{
+			name: "reject bad regex",
+			group: configpb.TestGroup{
+				EnableTestMethods:    true,
+				TestMethodMatchRegex: "(",
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := makeMethodOptions(tc.group)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("makeMethodOptions() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("makeMethodOptions() failed to return an error")
+			default:
+				if want := tc.expected.enabled && tc.group.TestMethodMatchRegex != ""; (actual.match != nil) != want {
+					t.Errorf("makeMethodOptions() got match %v for regex %q", actual.match, tc.group.TestMethodMatchRegex)
+				}
+				actual.match = nil
+				if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(methodOptions{})); diff != "" {
+					t.Errorf("makeMethodOptions() got unexpected diff (-have, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenTargets(t *testing.T) {
+	cases := []struct {
+		name     string
+		suites   []junit.Suite
+		expected []target
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "nested suites",
+			suites: []junit.Suite{
+				{
+					Name: "outer",
+					Suites: []junit.Suite{
+						{
+							Name:    "inner",
+							Results: []junit.Result{{Name: "a"}},
+						},
+						{
+							Name: "empty",
+						},
+					},
+					Results: []junit.Result{{Name: "b"}},
+				},
+				{
+					Results: []junit.Result{{Name: "c"}},
+				},
+			},
+			expected: []target{
+				{name: "outer.inner", results: []junit.Result{{Name: "a"}}},
+				{name: "outer", results: []junit.Result{{Name: "b"}}},
+				{results: []junit.Result{{Name: "c"}}},
+			},
+		},
+		{
+			name: "find results deeply nested in suites",
+			suites: []junit.Suite{
+				{
+					Name: "must",
+					Suites: []junit.Suite{
+						{
+							Name: "go",
+							Suites: []junit.Suite{
+								{
+									Name:    "deeper",
+									Results: []junit.Result{{Name: "leaf"}},
+								},
+							},
+							Results: []junit.Result{{Name: "branch"}},
+						},
+					},
+					Results: []junit.Result{{Name: "trunk"}},
+				},
+			},
+			expected: []target{
+				{name: "must.go.deeper", results: []junit.Result{{Name: "leaf"}}},
+				{name: "must.go", results: []junit.Result{{Name: "branch"}}},
+				{name: "must", results: []junit.Result{{Name: "trunk"}}},
+			},
+		},
+		{
+			name: "suite properties",
+			suites: []junit.Suite{
+				{
+					Name: "outer",
+					Properties: &junit.Properties{
+						PropertyList: []junit.Property{{Name: "cpus", Value: "4"}},
+					},
+					Suites: []junit.Suite{
+						{
+							Name: "inner",
+							Properties: &junit.Properties{
+								PropertyList: []junit.Property{{Name: "ops", Value: "10"}},
+							},
+							Results: []junit.Result{{Name: "a"}},
+						},
+					},
+					Results: []junit.Result{{Name: "b"}},
+				},
+			},
+			expected: []target{
+				{
+					name:    "outer.inner",
+					results: []junit.Result{{Name: "a"}},
+					properties: []junit.Property{
+						{Name: "cpus", Value: "4"},
+						{Name: "ops", Value: "10"},
+					},
+				},
+				{
+					name:       "outer",
+					results:    []junit.Result{{Name: "b"}},
+					properties: []junit.Property{{Name: "cpus", Value: "4"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := flattenTargets(tc.suites...)
+			if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(target{})); diff != "" {
+				t.Errorf("flattenTargets() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetCell(t *testing.T) {
+	pass := cell{result: statuspb.TestStatus_PASS, metrics: setElapsed(nil, 60)}
+	fail := cell{result: statuspb.TestStatus_FAIL, metrics: setElapsed(nil, 120)}
+	skip := cell{result: statuspb.TestStatus_PASS_WITH_SKIPS}
+	cases := []struct {
+		name     string
+		cells    map[string][]cell
+		flaky    bool
+		expected cell
+	}{
+		{
+			name:  "pass",
+			cells: map[string][]cell{"a": {pass}, "b": {pass}},
+			expected: cell{
+				result:  statuspb.TestStatus_PASS,
+				metrics: setElapsed(nil, 120),
+			},
+		},
+		{
+			name:  "skip",
+			cells: map[string][]cell{"a": {pass}, "b": {skip}},
+			expected: cell{
+				result:  statuspb.TestStatus_PASS_WITH_SKIPS,
+				metrics: setElapsed(nil, 60),
+			},
+		},
+		{
+			name:  "fail",
+			cells: map[string][]cell{"a": {pass}, "b": {fail}, "c": {skip}},
+			expected: cell{
+				result:  statuspb.TestStatus_FAIL,
+				icon:    "F",
+				message: "1 of 3 methods failed",
+				metrics: setElapsed(nil, 180),
+			},
+		},
+		{
+			name:  "retried methods fail without flaky status",
+			cells: map[string][]cell{"a": {fail, pass}},
+			expected: cell{
+				result:  statuspb.TestStatus_FAIL,
+				icon:    "F",
+				message: "1 of 1 methods failed",
+				metrics: setElapsed(nil, 180),
+			},
+		},
+		{
+			name:  "retried methods flake with flaky status",
+			cells: map[string][]cell{"a": {fail, pass}, "b": {pass}},
+			flaky: true,
+			expected: cell{
+				result:  statuspb.TestStatus_FLAKY,
+				message: "1 of 2 methods flaked",
+				metrics: setElapsed(nil, 240),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := targetCell(tc.cells, tc.flaky); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("targetCell() got %#v, want %#v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertResultMethods(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	now := time.Now().Unix()
+	finished := now + 1
+	yes := true
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{
+				Timestamp: now,
+			},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Timestamp: &finished,
+				Passed:    &yes,
+			},
+		},
+		suites: []gcs.SuitesMeta{
+			{
+				Suites: junit.Suites{
+					Suites: []junit.Suite{
+						{
+							Name: "pkg",
+							Results: []junit.Result{
+								{
+									Name:      "TestGood",
+									ClassName: "pkg.Class",
+								},
+								{
+									Name:      "TestBad",
+									ClassName: "pkg.Class",
+									Failure:   pstr("boom"),
+								},
+								{
+									Name: "helper",
+									Properties: &junit.Properties{
+										PropertyList: []junit.Property{
+											{Name: "size", Value: "small"},
+										},
+									},
+								},
+							},
+						},
+						{
+							Name: "big",
+							Results: []junit.Result{
+								{Name: "TestOne"},
+								{Name: "TestTwo"},
+								{Name: "TestThree"},
+								{Name: "TestFour"},
+							},
+						},
+						{
+							Results: []junit.Result{
+								{Name: "orphan"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	overall := cell{result: statuspb.TestStatus_PASS, metrics: setElapsed(nil, 1)}
+	pkgCell := cell{
+		result:  statuspb.TestStatus_FAIL,
+		icon:    "F",
+		message: "1 of 3 methods failed",
+	}
+	pass := cell{result: statuspb.TestStatus_PASS}
+	fail := cell{result: statuspb.TestStatus_FAIL, icon: "F", message: "boom"}
+
+	cases := []struct {
+		name     string
+		group    configpb.TestGroup
+		expected map[string]cell
+	}{
+		{
+			name: "disabled",
+			expected: map[string]cell{
+				"Overall":       overall,
+				"pkg.TestGood":  pass,
+				"pkg.TestBad":   fail,
+				"pkg.helper":    pass,
+				"big.TestOne":   pass,
+				"big.TestTwo":   pass,
+				"big.TestThree": pass,
+				"big.TestFour":  pass,
+				"orphan":        pass,
+			},
+		},
+		{
+			name: "enabled",
+			group: configpb.TestGroup{
+				EnableTestMethods: true,
+			},
+			expected: map[string]cell{
+				"Overall":                overall,
+				"pkg":                    pkgCell,
+				"pkg@TESTGRID@TestGood":  pass,
+				"pkg@TESTGRID@TestBad":   fail,
+				"pkg@TESTGRID@helper":    pass,
+				"big":                    pass,
+				"big@TESTGRID@TestOne":   pass,
+				"big@TESTGRID@TestTwo":   pass,
+				"big@TESTGRID@TestThree": pass,
+				"big@TESTGRID@TestFour":  pass,
+				"orphan":                 pass,
+			},
+		},
+		{
+			name: "full names and max methods",
+			group: configpb.TestGroup{
+				EnableTestMethods:     true,
+				UseFullMethodNames:    true,
+				MaxTestMethodsPerTest: 3,
+			},
+			expected: map[string]cell{
+				"Overall":                         overall,
+				"pkg":                             pkgCell,
+				"pkg@TESTGRID@pkg.Class.TestGood": pass,
+				"pkg@TESTGRID@pkg.Class.TestBad":  fail,
+				"pkg@TESTGRID@pkg.helper":         pass,
+				"big":                             pass,
+				"orphan":                          pass,
+			},
+		},
+		{
+			name: "match regex",
+			group: configpb.TestGroup{
+				EnableTestMethods:     true,
+				TestMethodMatchRegex:  "^Test(Bad|One|Two|Three)$",
+				MaxTestMethodsPerTest: 3,
+			},
+			expected: map[string]cell{
+				"Overall":                overall,
+				"pkg":                    pkgCell,
+				"pkg@TESTGRID@TestBad":   fail,
+				"big":                    pass,
+				"big@TESTGRID@TestOne":   pass,
+				"big@TESTGRID@TestTwo":   pass,
+				"big@TESTGRID@TestThree": pass,
+				"orphan":                 pass,
+			},
+		},
+		{
+			name: "method properties",
+			group: configpb.TestGroup{
+				EnableTestMethods: true,
+				TestMethodProperties: []*configpb.TestGroup_KeyValue{
+					{Key: "size", Value: "small"},
+				},
+			},
+			expected: map[string]cell{
+				"Overall":             overall,
+				"pkg":                 pkgCell,
+				"pkg@TESTGRID@helper": pass,
+				"big":                 pass,
+				"orphan":              pass,
+			},
+		},
+	}
+
+	nameCfg := nameConfig{
+		format: "%s",
+		parts:  []string{"Tests name"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := makeGroupOptions(tc.group)
+			if err != nil {
+				t.Fatalf("makeGroupOptions() got unexpected error: %v", err)
+			}
+			actual := convertResult(nameCfg, "build", nil, result, opt)
+			expected := inflatedColumn{
+				column: &statepb.Column{
+					Build:   "build",
+					Started: float64(now * 1000),
+				},
+				cells: tc.expected,
+			}
+			if diff := cmp.Diff(actual, expected, cmp.AllowUnexported(inflatedColumn{}, cell{}), cmp.Comparer(func(x, y *statepb.Column) bool {
+				return reflect.DeepEqual(x, y)
+			})); diff != "" {
+				t.Errorf("convertResult() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+)
+
+// allMetrics records every numeric property as a metric.
+const allMetrics = "*"
+
+// metricOptions configures which junit properties become metrics.
+type metricOptions struct {
+	all    bool            // record every numeric property
+	names  map[string]bool // record these numeric properties
+	suites bool            // testcases inherit the properties of their suites
+}
+
+func makeMetricOptions(tg configpb.TestGroup) metricOptions {
+	opt := metricOptions{
+		names:  map[string]bool{},
+		suites: tg.SuiteMetricProperties,
+	}
+	for _, name := range tg.MetricProperties {
+		if name == allMetrics {
+			opt.all = true
+			continue
+		}
+		opt.names[name] = true
+	}
+	if name := tg.ShortTextMetric; name != "" {
+		opt.names[name] = true
+	}
+	return opt
+}
+
+// include returns true when the named property should become a metric.
+//
+// Never overwrites the elapsed time.
+func (o metricOptions) include(name string) bool {
+	if name == elapsedKey {
+		return false
+	}
+	return o.all || o.names[name]
+}
+
+// addMetrics records the included numeric properties, creating metrics as necessary.
+//
+// Later properties with the same name take precedence.
+func (o metricOptions) addMetrics(metrics map[string]float64, props *junit.Properties) map[string]float64 {
+	if props == nil {
+		return metrics
+	}
+	for _, p := range props.PropertyList {
+		if !o.include(p.Name) {
+			continue
+		}
+		v, ok := parseMetric(p.Value)
+		if !ok {
+			continue
+		}
+		if metrics == nil {
+			metrics = map[string]float64{}
+		}
+		metrics[p.Name] = v
+	}
+	return metrics
+}
+
+// inheritMetrics adds the included numeric properties of the suites to the metrics of a result.
+//
+// The metrics of the result take precedence, followed by the innermost suite.
+func (o metricOptions) inheritMetrics(metrics map[string]float64, suiteProps []junit.Property) map[string]float64 {
+	inherited := o.addMetrics(nil, &junit.Properties{PropertyList: suiteProps})
+	for name, v := range inherited {
+		if _, ok := metrics[name]; ok {
+			continue
+		}
+		if metrics == nil {
+			metrics = map[string]float64{}
+		}
+		metrics[name] = v
+	}
+	return metrics
+}
+
+// parseMetric returns the value of a finite number.
+func parseMetric(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
+
+// metricSuffixes scale large values down by a thousand each.
+var metricSuffixes = []string{"", "k", "M", "G", "T"}
+
+// shortText formats a metric to fit inside a cell.
+//
+// Keeps about three significant digits, using a k/M/G/T suffix for large values,
+// so 0.1234 becomes 0.12, 85.37 becomes 85.4 and 1234 becomes 1.23k.
+func shortText(v float64) string {
+	var idx int
+	// Round before choosing the suffix, so 999.7 becomes 1k rather than 1000.
+	for math.Abs(math.Round(v)) >= 1000 && idx < len(metricSuffixes)-1 {
+		v /= 1000
+		idx++
+	}
+	prec := 0
+	switch abs := math.Abs(v); {
+	case abs < 10:
+		prec = 2
+	case abs < 100:
+		prec = 1
+	}
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s + metricSuffixes[idx]
+}
+
+// setShortText replaces the icon of the cell with the metric value, when present.
+func setShortText(c cell, metric string) cell {
+	if metric == "" {
+		return c
+	}
+	if v, ok := c.metrics[metric]; ok {
+		c.icon = shortText(v)
+	}
+	return c
+}
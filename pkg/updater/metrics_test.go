@@ -0,0 +1,408 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestMakeMetricOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		group    configpb.TestGroup
+		expected metricOptions
+	}{
+		{
+			name: "basically works",
+			expected: metricOptions{
+				names: map[string]bool{},
+			},
+		},
+		{
+			name: "allow list",
+			group: configpb.TestGroup{
+				MetricProperties:      []string{"ops", "bytes"},
+				SuiteMetricProperties: true,
+			},
+			expected: metricOptions{
+				names:  map[string]bool{"ops": true, "bytes": true},
+				suites: true,
+			},
+		},
+		{
+			name: "all properties",
+			group: configpb.TestGroup{
+				MetricProperties: []string{"*"},
+			},
+			expected: metricOptions{
+				all:   true,
+				names: map[string]bool{},
+			},
+		},
+		{
+			name: "include short text metric",
+			group: configpb.TestGroup{
+				ShortTextMetric: "coverage",
+			},
+			expected: metricOptions{
+				names: map[string]bool{"coverage": true},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := makeMetricOptions(tc.group)
+			if diff := cmp.Diff(actual, tc.expected, cmp.AllowUnexported(metricOptions{})); diff != "" {
+				t.Errorf("makeMetricOptions() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMetrics(t *testing.T) {
+	cases := []struct {
+		name     string
+		opt      metricOptions
+		metrics  map[string]float64
+		props    *junit.Properties
+		expected map[string]float64
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "allowed numeric properties",
+			opt: metricOptions{
+				names: map[string]bool{"ops": true, "bytes": true},
+			},
+			props: &junit.Properties{
+				PropertyList: []junit.Property{
+					{Name: "ops", Value: " 1234.5 "},
+					{Name: "bytes", Value: "many"},
+					{Name: "other", Value: "7"},
+				},
+			},
+			expected: map[string]float64{"ops": 1234.5},
+		},
+		{
+			name: "all numeric properties",
+			opt: metricOptions{
+				all: true,
+			},
+			metrics: setElapsed(nil, 60),
+			props: &junit.Properties{
+				PropertyList: []junit.Property{
+					{Name: "ops", Value: "1"},
+					{Name: "other", Value: "7"},
+					{Name: elapsedKey, Value: "100"},
+				},
+			},
+			expected: map[string]float64{
+				"ops":      1,
+				"other":    7,
+				elapsedKey: 1,
+			},
+		},
+		{
+			name: "last numeric value wins",
+			opt: metricOptions{
+				all: true,
+			},
+			props: &junit.Properties{
+				PropertyList: []junit.Property{
+					{Name: "ops", Value: "1"},
+					{Name: "ops", Value: "2"},
+					{Name: "ops", Value: "fast"},
+				},
+			},
+			expected: map[string]float64{"ops": 2},
+		},
+		{
+			name: "ignore non-finite values",
+			opt: metricOptions{
+				all: true,
+			},
+			props: &junit.Properties{
+				PropertyList: []junit.Property{
+					{Name: "ops", Value: "NaN"},
+					{Name: "ops", Value: "+Inf"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.opt.addMetrics(tc.metrics, tc.props)
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("addMetrics() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInheritMetrics(t *testing.T) {
+	opt := groupOptions{
+		metrics: metricOptions{
+			names:  map[string]bool{"cpus": true, "ops": true},
+			suites: true,
+		},
+		userProperty: "owner",
+	}
+	suites := []junit.Suite{
+		{
+			Name:       "outer",
+			Properties: props("cpus", "4", "owner", "me", "ops", "5"),
+			Suites: []junit.Suite{
+				{
+					Name:       "inner",
+					Properties: props("ops", "10"),
+					Results: []junit.Result{
+						{Name: "a"},
+						{Name: "b", Properties: props("ops", "20")},
+					},
+				},
+			},
+			Results: []junit.Result{
+				{Name: "c"},
+			},
+		},
+		{
+			Name:    "plain",
+			Results: []junit.Result{{Name: "d"}},
+		},
+	}
+
+	expected := map[string]map[string]float64{
+		"outer.inner.a": {"cpus": 4, "ops": 10},
+		"outer.inner.b": {"cpus": 4, "ops": 20},
+		"outer.c":       {"cpus": 4, "ops": 5},
+		"plain.d":       nil,
+	}
+	actual := map[string]map[string]float64{}
+	for _, tgt := range flattenTargets(suites...) {
+		for _, r := range tgt.results {
+			c := tgt.resultCell(r, opt)
+			actual[dotName(tgt.name, r.Name)] = c.metrics
+			if c.userProperty != "" {
+				t.Errorf("resultCell(%s) got user property %q from its suite", r.Name, c.userProperty)
+			}
+			if r.Name != "b" && r.Properties != nil {
+				t.Errorf("flattenTargets() added suite properties to %s: %v", r.Name, r.Properties)
+			}
+		}
+	}
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("resultCell() got unexpected diff (-have, +want):\n%s", diff)
+	}
+	if n := len(suites[0].Suites[0].Properties.PropertyList); n != 1 {
+		t.Errorf("flattenTargets() modified the original suite properties: got %d, want 1", n)
+	}
+
+	opt.metrics.suites = false
+	for _, tgt := range flattenTargets(suites...) {
+		for _, r := range tgt.results {
+			if r.Name == "c" {
+				if m := tgt.resultCell(r, opt).metrics; m != nil {
+					t.Errorf("resultCell() without suite metrics got %v, want nil", m)
+				}
+			}
+		}
+	}
+}
+
+func TestResultMetrics(t *testing.T) {
+	now := time.Now().Unix()
+	result := finishedAt(now, now+1, true)
+	result.suites = []gcs.SuitesMeta{
+		{
+			Suites: junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:       "bench",
+						Properties: props("cpus", "8"),
+						Results: []junit.Result{
+							{
+								Name:       "read",
+								Time:       30,
+								Properties: props("ops", "1234", "ignored", "5"),
+							},
+							{
+								Name:       "write",
+								Properties: props("ops", "slow", "cpus", "2"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opt := groupOptions{
+		metrics: metricOptions{
+			names:  map[string]bool{"ops": true, "cpus": true},
+			suites: true,
+		},
+	}
+	expected := passedColumn(now, map[string]cell{
+		"bench.read": {
+			result: statuspb.TestStatus_PASS,
+			metrics: map[string]float64{
+				elapsedKey: 0.5,
+				"ops":      1234,
+				"cpus":     8,
+			},
+		},
+		"bench.write": {
+			result:  statuspb.TestStatus_PASS,
+			metrics: map[string]float64{"cpus": 2},
+		},
+	})
+
+	if actual := convertResult(testNames, "", nil, result, opt); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("convertResult() got %v, want %v", actual, expected)
+	}
+}
+
+func TestShortText(t *testing.T) {
+	cases := []struct {
+		value    float64
+		expected string
+	}{
+		{value: 0, expected: "0"},
+		{value: 3, expected: "3"},
+		{value: 0.1234, expected: "0.12"},
+		{value: 0.001, expected: "0"},
+		{value: -0.001, expected: "0"},
+		{value: 85.37, expected: "85.4"},
+		{value: 100, expected: "100"},
+		{value: 999.4, expected: "999"},
+		{value: 999.7, expected: "1k"},
+		{value: -999.7, expected: "-1k"},
+		{value: 999.7e3, expected: "1M"},
+		{value: 99.96, expected: "100"},
+		{value: 1234, expected: "1.23k"},
+		{value: -45678, expected: "-45.7k"},
+		{value: 5600000, expected: "5.6M"},
+		{value: 7e9, expected: "7G"},
+		{value: 1.5e15, expected: "1500T"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.expected, func(t *testing.T) {
+			if actual := shortText(tc.value); actual != tc.expected {
+				t.Errorf("shortText(%v) got %q, want %q", tc.value, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestShortTextMetric(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	now := time.Now().Unix()
+	results := finishedAt(now, now+1, true)
+	results.suites = suitesWith(
+		junit.Result{
+			Name:       "bench",
+			Properties: props("ops", "1234"),
+		},
+		junit.Result{
+			Name:       "slow",
+			Failure:    pstr("too slow"),
+			Properties: props("ops", "85.37"),
+		},
+		junit.Result{
+			Name:       "skipped",
+			Skipped:    pstr("not today"),
+			Properties: props("ops", "unknown"),
+		},
+	)
+	cases := []struct {
+		name     string
+		result   gcsResult
+		opt      groupOptions
+		expected inflatedColumn
+	}{
+		{
+			name:   "short text metric replaces icons",
+			result: results,
+			opt: groupOptions{
+				shortText: "ops",
+				metrics: metricOptions{
+					names: map[string]bool{"ops": true},
+				},
+			},
+			expected: passedColumn(now, map[string]cell{
+				"bench": {
+					result:  statuspb.TestStatus_PASS,
+					icon:    "1.23k",
+					metrics: map[string]float64{"ops": 1234},
+				},
+				"slow": {
+					result:  statuspb.TestStatus_FAIL,
+					icon:    "85.4",
+					message: "too slow",
+					metrics: map[string]float64{"ops": 85.37},
+				},
+				"skipped": {
+					result:  statuspb.TestStatus_PASS_WITH_SKIPS,
+					icon:    "S",
+					message: "not today",
+				},
+			}),
+		},
+		{
+			name:   "short text metric shows elapsed minutes",
+			result: finishedAt(now, now+90, true),
+			opt: groupOptions{
+				shortText: elapsedKey,
+			},
+			expected: inflatedColumn{
+				column: &statepb.Column{
+					Started: float64(now * 1000),
+				},
+				cells: map[string]cell{
+					"Overall": {
+						result:  statuspb.TestStatus_PASS,
+						icon:    "1.5",
+						metrics: setElapsed(nil, 90),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := convertResult(testNames, "", nil, tc.result, tc.opt); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("convertResult() got %v, want %v", actual, tc.expected)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 
 	"cloud.google.com/go/storage"
@@ -63,12 +64,20 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 	if concurrency == 0 {
 		return nil, errors.New("zero readers")
 	}
+	opt, err := makeGroupOptions(group)
+	if err != nil {
+		return nil, fmt.Errorf("group options: %w", err)
+	}
+	formats, err := gcs.LookupFormats(group.ReportFormats...)
+	if err != nil {
+		return nil, fmt.Errorf("report formats: %w", err)
+	}
 
 	var wg sync.WaitGroup
 	defer wg.Wait()
 	var maxLock sync.Mutex
 
-	log := logrus.WithField("group", group.Name).WithField("prefix", "gs://"+group.GcsPrefix)
+	log := logrus.WithField("group", group.Name).WithField("prefix", group.GcsPrefix)
 
 	stop := stopTime.Unix() * 1000
 
@@ -101,11 +110,6 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 		}
 	}()
 
-	var heads []string
-	for _, h := range group.ColumnHeader {
-		heads = append(heads, h.ConfigurationValue)
-	}
-
 	// Concurrently receive indices and read builds
 	wg.Add(concurrency)
 	for i := 0; i < concurrency; i++ {
@@ -130,6 +134,7 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 				}
 
 				b := builds[idx]
+				b.Formats = formats
 
 				// use ctx so we finish reading, even if buildCtx is done
 				inner, cancel := context.WithTimeout(ctx, buildTimeout)
@@ -144,7 +149,11 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 					return
 				}
 				id := path.Base(b.Path.Object())
-				col := convertResult(nameCfg, id, heads, *result)
+				col := convertResult(nameCfg, id, group.ColumnHeader, *result, opt)
+				if opt.pending && (result.started.Pending || col.cells["Overall"].result == statuspb.TestStatus_RUNNING) {
+					log.WithField("id", id).Debug("Ignoring pending build")
+					continue
+				}
 				if int64(col.column.Started) < stop {
 					// Multiple go-routines may all read an old result.
 					// So we need to use a mutex to read the
@@ -192,7 +201,14 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 	cancel()  // no need to notify about an old index
 	wg.Wait() // wait for all the old indexes to sync
 
-	return cols[0:maxIdx], nil
+	out := cols[:0]
+	for _, col := range cols[0:maxIdx] {
+		if col.column == nil { // ignored pending build
+			continue
+		}
+		out = append(out, col)
+	}
+	return out, nil
 }
 
 // readResult will download all GCS artifacts in parallel.
@@ -200,7 +216,7 @@ func readColumns(parent context.Context, client gcs.Downloader, group configpb.T
 // Specifically download the following files:
 // * started.json
 // * finished.json
-// * any junit.xml files under the artifacts directory.
+// * any test reports under the artifacts directory.
 func readResult(parent context.Context, client gcs.Downloader, build gcs.Build) (*gcsResult, error) {
 	ctx, cancel := context.WithCancel(parent) // Allows aborting after first error
 	defer cancel()
@@ -267,7 +283,7 @@ func readResult(parent context.Context, client gcs.Downloader, build gcs.Build)
 	return &result, nil
 }
 
-// readSuites asynchrounously lists and downloads junit.xml files
+// readSuites asynchrounously lists and downloads the build's test reports
 func readSuites(parent context.Context, client gcs.Downloader, build gcs.Build) ([]gcs.SuitesMeta, error) {
 	var wg sync.WaitGroup
 	defer wg.Wait()
@@ -27,6 +27,7 @@ import (
 	"net/url"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -347,6 +348,76 @@ func TestReadColumns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "read test2json reports",
+			builds: []fakeBuild{
+				{
+					id: "10",
+					started: &fakeObject{
+						data: jsonData(metadata.Started{Timestamp: now + 10}),
+					},
+					finished: &fakeObject{
+						data: jsonData(metadata.Finished{
+							Timestamp: pint64(now + 20),
+							Passed:    &yes,
+						}),
+					},
+					artifacts: map[string]fakeObject{
+						"artifacts/junit_01.xml": {
+							data: makeJunit([]string{"good"}, nil),
+						},
+						"artifacts/test2json_unit.json": {
+							data: strings.Join([]string{
+								`{"Action":"run","Package":"example.com/foo","Test":"TestFoo"}`,
+								`{"Action":"run","Package":"example.com/foo","Test":"TestFoo/bar"}`,
+								`{"Action":"output","Package":"example.com/foo","Test":"TestFoo/bar","Output":"    foo_test.go:12: boom\n"}`,
+								`{"Action":"fail","Package":"example.com/foo","Test":"TestFoo/bar"}`,
+								`{"Action":"fail","Package":"example.com/foo","Test":"TestFoo"}`,
+								`{"Action":"fail","Package":"example.com/foo"}`,
+								`{"Action":"output","Package":"example.com/bar","Output":"FAIL\texample.com/bar [build failed]\n"}`,
+								`{"Action":"fail","Package":"example.com/bar"}`,
+							}, "\n"),
+						},
+					},
+				},
+			},
+			group: configpb.TestGroup{
+				GcsPrefix:     "bucket/path/to/build/",
+				ReportFormats: []string{"junit", "test2json"},
+			},
+			expected: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "10",
+						Started: float64(now+10) * 1000,
+					},
+					cells: map[string]cell{
+						"Overall": {
+							result: statuspb.TestStatus_PASS,
+							metrics: map[string]float64{
+								"test-duration-minutes": 10 / 60.0,
+							},
+						},
+						"good": {
+							result: statuspb.TestStatus_PASS,
+						},
+						"example.com/foo.TestFoo": {
+							result: statuspb.TestStatus_FAIL,
+						},
+						"example.com/foo.TestFoo/bar": {
+							result:  statuspb.TestStatus_FAIL,
+							icon:    "F",
+							message: "foo_test.go:12: boom",
+						},
+						"example.com/bar": {
+							result:  statuspb.TestStatus_FAIL,
+							icon:    "F",
+							message: "FAIL\texample.com/bar [build failed]",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "stop columns at max",
 			max:  2,
@@ -714,6 +785,97 @@ func TestReadColumns(t *testing.T) {
 				// drop 11 and 10
 			},
 		},
+		{
+			name: "ignore pending builds",
+			builds: []fakeBuild{
+				{
+					id: "12", // not started
+				},
+				{
+					id: "11", // still running
+					started: &fakeObject{
+						data: jsonData(metadata.Started{Timestamp: now + 11}),
+					},
+				},
+				{
+					id: "10",
+					started: &fakeObject{
+						data: jsonData(metadata.Started{Timestamp: now + 10}),
+					},
+					finished: &fakeObject{
+						data: jsonData(metadata.Finished{
+							Timestamp: pint64(now + 20),
+							Passed:    &yes,
+						}),
+					},
+				},
+			},
+			group: configpb.TestGroup{
+				GcsPrefix:     "bucket/path/to/build/",
+				IgnorePending: true,
+			},
+			stop: time.Unix(now, 0),
+			expected: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "10",
+						Started: float64(now+10) * 1000,
+					},
+					cells: map[string]cell{
+						"Overall": {
+							result: statuspb.TestStatus_PASS,
+							metrics: map[string]float64{
+								"test-duration-minutes": 10 / 60.0,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "show pending builds by default",
+			builds: []fakeBuild{
+				{
+					id: "11",
+					started: &fakeObject{
+						data: jsonData(metadata.Started{Timestamp: now + 11}),
+					},
+				},
+			},
+			group: configpb.TestGroup{
+				GcsPrefix: "bucket/path/to/build/",
+			},
+			expected: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "11",
+						Started: float64(now+11) * 1000,
+					},
+					cells: map[string]cell{
+						"Overall": {
+							result:  statuspb.TestStatus_RUNNING,
+							icon:    "R",
+							message: "Build still running...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "bad group options return error",
+			group: configpb.TestGroup{
+				EnableTestMethods:    true,
+				TestMethodMatchRegex: "(",
+			},
+			err: true,
+		},
+		{
+			name: "unknown report formats return error",
+			group: configpb.TestGroup{
+				ReportFormats: []string{"unknown"},
+			},
+			err: true,
+		},
 		{
 			name: "cancelled context returns error",
 			ctx: func() context.Context {
@@ -963,7 +1125,7 @@ func TestReadResult(t *testing.T) {
 			for name, fo := range tc.data {
 				p, err := path.ResolveReference(&url.URL{Path: name})
 				if err != nil {
-					t.Fatalf("path.ResolveReference(%q): %w", name, err)
+					t.Fatalf("path.ResolveReference(%q): %v", name, err)
 				}
 				fi.objects = append(fi.objects, storage.ObjectAttrs{
 					Name: p.Object(),
@@ -1132,7 +1294,7 @@ func TestReadSuites(t *testing.T) {
 			for name, fo := range tc.data {
 				p, err := path.ResolveReference(&url.URL{Path: name})
 				if err != nil {
-					t.Fatalf("path.ResolveReference(%q): %w", name, err)
+					t.Fatalf("path.ResolveReference(%q): %v", name, err)
 				}
 				fi.objects = append(fi.objects, storage.ObjectAttrs{
 					Name: p.Object(),
@@ -1184,6 +1346,20 @@ func (fo fakeOpener) Open(ctx context.Context, path gcs.Path) (io.ReadCloser, er
 	}, nil
 }
 
+func (fo fakeOpener) Stat(ctx context.Context, path gcs.Path) (*storage.ObjectAttrs, error) {
+	o, ok := fo[path]
+	if !ok {
+		return nil, fmt.Errorf("wrap not exist: %w", storage.ErrObjectNotExist)
+	}
+	if o.openErr != nil {
+		return nil, o.openErr
+	}
+	return &storage.ObjectAttrs{
+		Name: path.Object(),
+		Size: int64(len(o.data)),
+	}, nil
+}
+
 type fakeObject struct {
 	data     string
 	openErr  error
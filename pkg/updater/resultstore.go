@@ -0,0 +1,351 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// invocationFields are the invocation fields needed to create a column.
+var invocationFields = []string{
+	"invocations.name",
+	"invocations.timing",
+	"invocations.status_attributes",
+	"invocations.properties",
+}
+
+// allActions is the parent of every action in the invocation.
+const allActions = "/targets/-/configuredTargets/-"
+
+// listInvocations returns the invocations of the group started at or after stop, newest first.
+func listInvocations(ctx context.Context, client *resultstore.Client, rsConfig *configpb.ResultStoreConfig, stop time.Time) ([]*resultstore.Invocation, error) {
+	query := fmt.Sprintf("timing.start_time>=%q", stop.UTC().Format(time.RFC3339))
+	if rsConfig.Query != "" {
+		query = rsConfig.Query + " AND " + query
+	}
+	invs, err := client.Invocations().Search(ctx, rsConfig.Project, query, invocationFields...)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	out := invs[:0]
+	for _, inv := range invs {
+		if inv.Start.Before(stop) {
+			continue
+		}
+		out = append(out, inv)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Start.After(out[j].Start)
+	})
+	return out, nil
+}
+
+// readInvocations concurrently reads the test actions of each invocation into a column.
+func readInvocations(parent context.Context, client *resultstore.Client, group configpb.TestGroup, invs []*resultstore.Invocation, max int, concurrency int) ([]inflatedColumn, error) {
+	if concurrency == 0 {
+		return nil, errors.New("zero readers")
+	}
+	opt, err := makeGroupOptions(group)
+	if err != nil {
+		return nil, fmt.Errorf("group options: %w", err)
+	}
+	log := logrus.WithField("group", group.Name)
+	if n := len(invs); n > max {
+		log.WithField("total", n).WithField("max", max).Debug("Truncating")
+		invs = invs[:max]
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	rs := *client
+	rs.WithContext(ctx)
+	nameCfg := makeNameConfig(group.TestNameConfig)
+
+	cols := make([]inflatedColumn, len(invs))
+	indices := make(chan int)
+	errs := make(chan error, concurrency)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				inv := invs[idx]
+				tests, err := rs.Actions(inv.Name + allActions).List()
+				if err != nil {
+					errs <- fmt.Errorf("list %s actions: %w", inv.Name, err)
+					cancel()
+					return
+				}
+				cols[idx] = convertInvocation(nameCfg, group.ColumnHeader, *inv, tests, opt)
+			}
+		}()
+	}
+	func() {
+		defer close(indices)
+		for i := range invs {
+			select {
+			case <-ctx.Done():
+				return
+			case indices <- i:
+			}
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+
+	out := cols[:0]
+	for _, col := range cols {
+		if opt.pending && col.cells["Overall"].result == statuspb.TestStatus_RUNNING {
+			log.WithField("id", col.column.Build).Debug("Ignoring pending invocation")
+			continue
+		}
+		out = append(out, col)
+	}
+	return out, nil
+}
+
+// convertInvocation returns the column of an invocation and its test actions.
+//
+// Test cases become rows like junit results, with an additional row for each target
+// without one. The invocation and target statuses determine the results of their rows.
+func convertInvocation(nameCfg nameConfig, headers []*configpb.TestGroup_ColumnHeader, inv resultstore.Invocation, tests []resultstore.Test, opt groupOptions) inflatedColumn {
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{
+				Timestamp: inv.Start.Unix(),
+			},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{
+				Metadata: metadata.Metadata{},
+			},
+		},
+	}
+	for i := range inv.Properties {
+		p := &inv.Properties[i]
+		result.finished.Metadata[p.Key] = p.Value
+	}
+	overall := convertStatus(inv.Status)
+	done := overall != statuspb.TestStatus_RUNNING && overall != statuspb.TestStatus_NO_RESULT
+	if done {
+		when := inv.Start.Add(inv.Duration).Unix()
+		passed := overall == statuspb.TestStatus_PASS || overall == statuspb.TestStatus_FLAKY || overall == statuspb.TestStatus_BUILD_PASSED
+		result.finished.Timestamp = &when
+		result.finished.Passed = &passed
+	}
+
+	var suites junit.Suites
+	targets := map[string]resultstore.Test{}
+	var names []string
+	for _, test := range tests {
+		name := targetName(test.Name)
+		if _, present := targets[name]; !present {
+			names = append(names, name)
+		}
+		targets[name] = test
+		suite := convertSuite(test.Suite)
+		suite.Name = name
+		suites.Suites = append(suites.Suites, suite)
+	}
+	result.suites = []gcs.SuitesMeta{{Suites: suites}}
+
+	col := convertResult(nameCfg, path.Base(inv.Name), headers, result, opt)
+
+	if done {
+		c := col.cells["Overall"]
+		c.result = overall
+		if msg := inv.Description; msg != "" {
+			c.message = msg
+		}
+		c.icon = statusIcon(overall, c.message)
+		col.cells["Overall"] = setShortText(c, opt.shortText)
+	}
+
+	for _, name := range names {
+		if _, present := col.cells[name]; present {
+			continue
+		}
+		test := targets[name]
+		c := cell{
+			result:  convertStatus(test.Status),
+			message: test.Description,
+		}
+		if c.result == statuspb.TestStatus_NO_RESULT {
+			continue
+		}
+		switch {
+		case opt.skip && c.result == statuspb.TestStatus_PASS_WITH_SKIPS:
+			continue
+		case opt.built && c.result == statuspb.TestStatus_BUILD_PASSED:
+			continue
+		}
+		c.icon = statusIcon(c.result, c.message)
+		if test.Action.Duration > 0 {
+			c.metrics = setElapsed(nil, test.Action.Duration.Seconds())
+		}
+		col.cells[name] = c
+	}
+	return col
+}
+
+// targetName returns the target of an action, such as //foo:bar_test.
+//
+// Action names look like invocations/ID/targets/TARGET/configuredTargets/CONFIG/actions/ACTION.
+func targetName(actionName string) string {
+	const prefix, suffix = "/targets/", "/configuredTargets/"
+	start := strings.Index(actionName, prefix)
+	if start < 0 {
+		return actionName
+	}
+	name := actionName[start+len(prefix):]
+	if end := strings.Index(name, suffix); end >= 0 {
+		name = name[:end]
+	}
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	return name
+}
+
+// convertSuite converts a ResultStore test suite into a junit suite.
+func convertSuite(suite resultstore.Suite) junit.Suite {
+	out := junit.Suite{
+		Name:       suite.Name,
+		Time:       suite.Duration.Seconds(),
+		Properties: convertProperties(suite.Properties),
+	}
+	for _, child := range suite.Suites {
+		out.Suites = append(out.Suites, convertSuite(child))
+	}
+	for _, c := range suite.Cases {
+		out.Results = append(out.Results, convertCase(c))
+	}
+	return out
+}
+
+// convertCase converts a ResultStore test case into a junit result.
+//
+// Failures and errors both fail the result. Skipped cases, like empty junit skips, have no row.
+func convertCase(c resultstore.Case) junit.Result {
+	r := junit.Result{
+		Name:       c.Name,
+		ClassName:  c.Class,
+		Time:       c.Duration.Seconds(),
+		Properties: convertProperties(c.Properties),
+	}
+	var msgs []string
+	for _, f := range c.Failures {
+		msgs = append(msgs, f.Message)
+	}
+	for _, e := range c.Errors {
+		msgs = append(msgs, e.Message)
+	}
+	switch {
+	case len(msgs) > 0:
+		msg := strings.Join(msgs, "\n")
+		r.Failure = &msg
+	case c.Result == resultstore.Cancelled:
+		msg := "Cancelled"
+		r.Failure = &msg
+	case c.Result == resultstore.Skipped:
+		var msg string
+		r.Skipped = &msg
+	}
+	return r
+}
+
+func convertProperties(props []resultstore.Property) *junit.Properties {
+	if len(props) == 0 {
+		return nil
+	}
+	var out junit.Properties
+	for i := range props {
+		out.PropertyList = append(out.PropertyList, junit.Property{Name: props[i].Key, Value: props[i].Value})
+	}
+	return &out
+}
+
+// convertStatus returns the test status corresponding to a ResultStore status.
+func convertStatus(status resultstore.Status) statuspb.TestStatus {
+	switch status {
+	case resultstorepb.Status_BUILDING, resultstorepb.Status_TESTING:
+		return statuspb.TestStatus_RUNNING
+	case resultstorepb.Status_BUILT:
+		return statuspb.TestStatus_BUILD_PASSED
+	case resultstorepb.Status_FAILED_TO_BUILD:
+		return statuspb.TestStatus_BUILD_FAIL
+	case resultstorepb.Status_PASSED:
+		return statuspb.TestStatus_PASS
+	case resultstorepb.Status_FAILED:
+		return statuspb.TestStatus_FAIL
+	case resultstorepb.Status_TIMED_OUT:
+		return statuspb.TestStatus_TIMED_OUT
+	case resultstorepb.Status_CANCELLED:
+		return statuspb.TestStatus_CANCEL
+	case resultstorepb.Status_TOOL_FAILED:
+		return statuspb.TestStatus_TOOL_FAIL
+	case resultstorepb.Status_INCOMPLETE:
+		return statuspb.TestStatus_CATEGORIZED_ABORT
+	case resultstorepb.Status_FLAKY:
+		return statuspb.TestStatus_FLAKY
+	case resultstorepb.Status_UNKNOWN:
+		return statuspb.TestStatus_UNKNOWN
+	case resultstorepb.Status_SKIPPED:
+		return statuspb.TestStatus_PASS_WITH_SKIPS
+	}
+	return statuspb.TestStatus_NO_RESULT
+}
+
+// statusIcon returns the icon for a status, like those of junit results and builds.
+func statusIcon(status statuspb.TestStatus, message string) string {
+	switch status {
+	case statuspb.TestStatus_FAIL, statuspb.TestStatus_BUILD_FAIL:
+		if message != "" {
+			return "F"
+		}
+	case statuspb.TestStatus_TIMED_OUT:
+		return "T"
+	case statuspb.TestStatus_PASS_WITH_SKIPS:
+		return "S"
+	}
+	return ""
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore/fake"
+)
+
+func TestTargetName(t *testing.T) {
+	cases := []struct {
+		name     string
+		action   string
+		expected string
+	}{
+		{
+			name:     "basically works",
+			action:   "invocations/id/targets/foo/configuredTargets/default/actions/test",
+			expected: "foo",
+		},
+		{
+			name:     "unescape target",
+			action:   "invocations/id/targets/%2F%2Ffoo:bar_test/configuredTargets/default/actions/test",
+			expected: "//foo:bar_test",
+		},
+		{
+			name:     "not an action",
+			action:   "something",
+			expected: "something",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := targetName(tc.action); actual != tc.expected {
+				t.Errorf("targetName(%q) got %q, want %q", tc.action, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertStatus(t *testing.T) {
+	cases := []struct {
+		status   resultstore.Status
+		expected statuspb.TestStatus
+	}{
+		{
+			status:   resultstorepb.Status_STATUS_UNSPECIFIED,
+			expected: statuspb.TestStatus_NO_RESULT,
+		},
+		{
+			status:   resultstorepb.Status_TESTING,
+			expected: statuspb.TestStatus_RUNNING,
+		},
+		{
+			status:   resultstorepb.Status_PASSED,
+			expected: statuspb.TestStatus_PASS,
+		},
+		{
+			status:   resultstorepb.Status_FAILED,
+			expected: statuspb.TestStatus_FAIL,
+		},
+		{
+			status:   resultstorepb.Status_FAILED_TO_BUILD,
+			expected: statuspb.TestStatus_BUILD_FAIL,
+		},
+		{
+			status:   resultstorepb.Status_INCOMPLETE,
+			expected: statuspb.TestStatus_CATEGORIZED_ABORT,
+		},
+		{
+			status:   resultstorepb.Status_SKIPPED,
+			expected: statuspb.TestStatus_PASS_WITH_SKIPS,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			if actual := convertStatus(tc.status); actual != tc.expected {
+				t.Errorf("convertStatus(%s) got %s, want %s", tc.status, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertCase(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	cases := []struct {
+		name     string
+		c        resultstore.Case
+		expected junit.Result
+	}{
+		{
+			name: "basically works",
+			c: resultstore.Case{
+				Name:     "hello",
+				Class:    "world",
+				Result:   resultstore.Completed,
+				Duration: 2 * time.Second,
+			},
+			expected: junit.Result{
+				Name:      "hello",
+				ClassName: "world",
+				Time:      2,
+			},
+		},
+		{
+			name: "failures and errors",
+			c: resultstore.Case{
+				Name:     "hello",
+				Result:   resultstore.Completed,
+				Failures: []resultstore.Failure{{Message: "bad"}},
+				Errors:   []resultstore.Error{{Message: "worse"}},
+			},
+			expected: junit.Result{
+				Name:    "hello",
+				Failure: pstr("bad\nworse"),
+			},
+		},
+		{
+			name: "cancelled",
+			c: resultstore.Case{
+				Name:   "hello",
+				Result: resultstore.Cancelled,
+			},
+			expected: junit.Result{
+				Name:    "hello",
+				Failure: pstr("Cancelled"),
+			},
+		},
+		{
+			name: "skipped",
+			c: resultstore.Case{
+				Name:   "hello",
+				Result: resultstore.Skipped,
+			},
+			expected: junit.Result{
+				Name:    "hello",
+				Skipped: pstr(""),
+			},
+		},
+		{
+			name: "properties",
+			c: resultstore.Case{
+				Name:       "hello",
+				Properties: []resultstore.Property{{Key: "foo", Value: "bar"}},
+			},
+			expected: junit.Result{
+				Name: "hello",
+				Properties: &junit.Properties{
+					PropertyList: []junit.Property{{Name: "foo", Value: "bar"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := convertCase(tc.c)
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("convertCase() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConvertInvocation(t *testing.T) {
+	start := time.Unix(300, 0)
+	cases := []struct {
+		name     string
+		inv      resultstore.Invocation
+		tests    []resultstore.Test
+		opt      groupOptions
+		expected inflatedColumn
+	}{
+		{
+			name: "running invocation",
+			inv: resultstore.Invocation{
+				Name:   "invocations/hello",
+				Start:  time.Now(),
+				Status: resultstorepb.Status_TESTING,
+			},
+			expected: inflatedColumn{
+				column: &statepb.Column{
+					Build: "hello",
+				},
+				cells: map[string]cell{
+					"Overall": {
+						result:  statuspb.TestStatus_RUNNING,
+						icon:    "R",
+						message: "Build still running...",
+					},
+				},
+			},
+		},
+		{
+			name: "passing invocation",
+			inv: resultstore.Invocation{
+				Name:       "invocations/hello",
+				Start:      start,
+				Duration:   time.Minute,
+				Status:     resultstorepb.Status_PASSED,
+				Properties: []resultstore.Property{{Key: "Commit", Value: "abc"}},
+			},
+			tests: []resultstore.Test{
+				{
+					Name: "invocations/hello/targets/%2F%2Ffoo:bar/configuredTargets/default/actions/test",
+					Action: resultstore.Action{
+						Status:   resultstorepb.Status_PASSED,
+						Duration: 2 * time.Minute,
+					},
+					Suite: resultstore.Suite{
+						Cases: []resultstore.Case{
+							{Name: "case", Duration: time.Minute},
+						},
+					},
+				},
+				{
+					Name: "invocations/hello/targets/%2F%2Ffoo:empty/configuredTargets/default/actions/test",
+					Action: resultstore.Action{
+						Status:   resultstorepb.Status_PASSED,
+						Duration: time.Minute,
+					},
+				},
+			},
+			expected: inflatedColumn{
+				column: &statepb.Column{
+					Build:   "hello",
+					Started: 300 * 1000,
+					Extra:   []string{"abc"},
+				},
+				cells: map[string]cell{
+					"Overall": {
+						result:  statuspb.TestStatus_PASS,
+						metrics: setElapsed(nil, 60),
+					},
+					"//foo:bar": {
+						result:  statuspb.TestStatus_PASS,
+						metrics: setElapsed(nil, 120),
+					},
+					"//foo:bar.case": {
+						result:  statuspb.TestStatus_PASS,
+						metrics: setElapsed(nil, 60),
+					},
+					"//foo:empty": {
+						result:  statuspb.TestStatus_PASS,
+						metrics: setElapsed(nil, 60),
+					},
+				},
+			},
+		},
+		{
+			name: "failing target",
+			inv: resultstore.Invocation{
+				Name:        "invocations/hello",
+				Start:       start,
+				Duration:    time.Minute,
+				Status:      resultstorepb.Status_FAILED,
+				Description: "boom",
+			},
+			tests: []resultstore.Test{
+				{
+					Name: "invocations/hello/targets/broken/configuredTargets/default/actions/build",
+					Action: resultstore.Action{
+						Status:      resultstorepb.Status_FAILED_TO_BUILD,
+						Description: "compile error",
+					},
+				},
+				{
+					Name: "invocations/hello/targets/unknown/configuredTargets/default/actions/build",
+				},
+			},
+			expected: inflatedColumn{
+				column: &statepb.Column{
+					Build:   "hello",
+					Started: 300 * 1000,
+					Extra:   []string{"missing"},
+				},
+				cells: map[string]cell{
+					"Overall": {
+						result:  statuspb.TestStatus_FAIL,
+						icon:    "F",
+						message: "boom",
+						metrics: setElapsed(nil, 60),
+					},
+					"broken": {
+						result:  statuspb.TestStatus_BUILD_FAIL,
+						icon:    "F",
+						message: "compile error",
+					},
+				},
+			},
+		},
+	}
+
+	headers := []*configpb.TestGroup_ColumnHeader{{ConfigurationValue: "Commit"}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			nameCfg := makeNameConfig(nil)
+			actual := convertInvocation(nameCfg, headers, tc.inv, tc.tests, tc.opt)
+			if tc.inv.Status == resultstorepb.Status_TESTING {
+				tc.expected.column.Started = actual.column.Started
+				tc.expected.column.Extra = actual.column.Extra
+			}
+			if diff := cmp.Diff(tc.expected, actual, cmp.AllowUnexported(inflatedColumn{}, cell{}), protocmp.Transform()); diff != "" {
+				t.Errorf("convertInvocation() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadInvocations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := fake.NewServer()
+	now := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i, id := range []string{"old", "new", "other"} {
+		inv := resultstore.Invocation{
+			Name:     "invocations/" + id,
+			Project:  "project",
+			Start:    now.Add(time.Duration(i) * time.Minute),
+			Duration: time.Minute,
+			Status:   resultstorepb.Status_PASSED,
+		}
+		if id == "other" {
+			inv.Project = "elsewhere"
+		}
+		srv.AddInvocation(inv.To())
+		srv.AddAction(resultstore.Test{
+			Name:   inv.Name + "/targets/foo/configuredTargets/default/actions/test",
+			Action: resultstore.Action{Status: resultstorepb.Status_FAILED},
+		}.To())
+	}
+	conn, stop, err := srv.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() got unexpected error: %v", err)
+	}
+	defer stop()
+	client := resultstore.NewClient(conn)
+
+	rsConfig := &configpb.ResultStoreConfig{Project: "project"}
+	invs, err := listInvocations(ctx, client, rsConfig, now)
+	if err != nil {
+		t.Fatalf("listInvocations() got unexpected error: %v", err)
+	}
+	var names []string
+	for _, inv := range invs {
+		names = append(names, inv.Name)
+	}
+	if diff := cmp.Diff([]string{"invocations/new", "invocations/old"}, names); diff != "" {
+		t.Errorf("listInvocations() got unexpected diff (-want +got):\n%s", diff)
+	}
+
+	group := configpb.TestGroup{Name: "group"}
+	cols, err := readInvocations(ctx, client, group, invs, 1, 2)
+	if err != nil {
+		t.Fatalf("readInvocations() got unexpected error: %v", err)
+	}
+	if len(cols) != 1 {
+		t.Fatalf("readInvocations() got %d columns, want 1", len(cols))
+	}
+	if build := cols[0].column.Build; build != "new" {
+		t.Errorf("readInvocations() got build %q, want new", build)
+	}
+	if result := cols[0].cells["foo"].result; result != statuspb.TestStatus_FAIL {
+		t.Errorf("readInvocations() got foo %s, want FAIL", result)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"crypto/subtle"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	updaterpb "github.com/GoogleCloudPlatform/testgrid/pb/updater"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/store"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Server updates test groups on request.
+type Server struct {
+	updaterpb.UnimplementedUpdaterServer
+
+	client           gcs.Client
+	rsClient         *resultstore.Client
+	store            *store.Reader
+	configPath       gcs.Path
+	gridPrefix       string
+	buildConcurrency int
+	write            bool
+	token            string
+	groupTimeout     time.Duration
+	buildTimeout     time.Duration
+}
+
+// NewServer returns an updater server writing grid state under gridPrefix, relative to the configPath.
+//
+// Requests must send an "authorization: Bearer <token>" header when the server writes,
+// so a writing server with an empty token rejects every request.
+func NewServer(client gcs.Client, rsClient *resultstore.Client, configPath gcs.Path, gridPrefix string, buildConcurrency int, write bool, token string, groupTimeout, buildTimeout time.Duration) *Server {
+	return &Server{
+		client:           client,
+		rsClient:         rsClient,
+		store:            store.NewReader(client, configPath),
+		configPath:       configPath,
+		gridPrefix:       gridPrefix,
+		buildConcurrency: buildConcurrency,
+		write:            write,
+		token:            token,
+		groupTimeout:     groupTimeout,
+		buildTimeout:     buildTimeout,
+	}
+}
+
+// authorize returns an error unless the request carries the server's bearer token.
+func (s *Server) authorize(ctx context.Context) error {
+	if s.token == "" {
+		return status.Error(codes.PermissionDenied, "updates that write require a token")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+// Update updates the requested test group and then summarizes the requested dashboard tabs.
+//
+// Groups and tabs are identified by name and read from the configuration;
+// the rest of the requested protos is ignored.
+// Tabs summarize the grid state in storage, so they reflect this update only when the server writes.
+func (s *Server) Update(ctx context.Context, req *updaterpb.UpdateRequest) (*updaterpb.UpdateResponse, error) {
+	if s.write {
+		if err := s.authorize(ctx); err != nil {
+			return nil, err
+		}
+	}
+	name := req.GetTestGroup().GetName()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "test_group.name is required")
+	}
+	if strings.Contains(name, "/") || strings.Contains(name, "..") {
+		return nil, status.Errorf(codes.InvalidArgument, "test_group.name %q must not contain / or ..", name)
+	}
+	cfg, _, err := s.store.Config(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to read config")
+		return nil, status.Errorf(codes.Unavailable, "read config: %v", err)
+	}
+	tg := config.FindTestGroup(name, cfg)
+	if tg == nil {
+		return nil, status.Errorf(codes.NotFound, "test group %s", name)
+	}
+	type dashTab struct {
+		dashboard string
+		tab       *configpb.DashboardTab
+	}
+	var tabs []dashTab
+	for _, id := range req.DashboardTabIdentifiers {
+		tabName := id.GetDashboardTab().GetName()
+		if tabName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "dashboard %s: dashboard_tab.name is required", id.GetDashboardName())
+		}
+		tab := config.FindDashboardTab(id.GetDashboardName(), tabName, cfg)
+		if tab == nil {
+			return nil, status.Errorf(codes.NotFound, "dashboard %s tab %s", id.GetDashboardName(), tabName)
+		}
+		if tab.TestGroupName != tg.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "dashboard %s tab %s shows %s, not %s", id.GetDashboardName(), tabName, tab.TestGroupName, tg.Name)
+		}
+		tabs = append(tabs, dashTab{id.GetDashboardName(), tab})
+	}
+	log := logrus.WithField("group", tg.Name)
+	gridPath, err := testGroupPath(s.configPath, path.Join(s.gridPrefix, tg.Name))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "grid path: %v", err)
+	}
+	resp, err := updateGroup(ctx, s.client, s.rsClient, *tg, *gridPath, s.buildConcurrency, s.write, s.groupTimeout, s.buildTimeout)
+	if err != nil {
+		log.WithError(err).Error("Failed to update group")
+		return nil, status.Errorf(codes.Internal, "update %s: %v", tg.Name, err)
+	}
+	for _, dt := range tabs {
+		sum, err := summarizer.SummarizeTab(ctx, s.client, dt.dashboard, dt.tab, tg, *gridPath)
+		if err != nil {
+			log.WithError(err).WithField("dashboard", dt.dashboard).WithField("tab", dt.tab.Name).Error("Failed to summarize tab")
+		}
+		resp.DashboardTabSummaries = append(resp.DashboardTabSummaries, sum)
+	}
+	return resp, nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	updaterpb "github.com/GoogleCloudPlatform/testgrid/pb/updater"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func TestServerUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "updater-server")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	root := newPathOrDie("file://" + filepath.ToSlash(dir) + "/")
+	client := gcs.NewLocalClient()
+	ctx := context.Background()
+
+	upload := func(name string, data string) {
+		if err := client.Upload(ctx, *resolveOrDie(&root, name), []byte(data), gcs.DefaultAcl, ""); err != nil {
+			t.Fatalf("Failed to upload %s: %v", name, err)
+		}
+	}
+	now := time.Now().Unix()
+	for i, build := range []string{"1", "2"} {
+		upload("logs/hello/"+build+"/started.json", jsonStarted(now+int64(i)).data)
+		upload("logs/hello/"+build+"/finished.json", jsonFinished(now+int64(i)+1, true, nil).data)
+		upload("logs/hello/"+build+"/artifacts/junit.xml", makeJunit([]string{"good"}, []string{"bad"}))
+	}
+
+	group := &configpb.TestGroup{
+		Name:             "hello",
+		GcsPrefix:        resolveOrDie(&root, "logs/hello").String(),
+		DaysOfResults:    7,
+		NumColumnsRecent: 6,
+	}
+	tab := &configpb.DashboardTab{
+		Name:          "hello-tab",
+		TestGroupName: "hello",
+	}
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			group,
+			{Name: "other", GcsPrefix: resolveOrDie(&root, "logs/other").String(), DaysOfResults: 7, NumColumnsRecent: 6},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					tab,
+					{Name: "other-tab", TestGroupName: "other"},
+				},
+			},
+		},
+	}
+	buf, err := config.MarshalBytes(cfg)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+	upload("config", string(buf))
+	const token = "secret"
+	authorized := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+
+	cases := []struct {
+		name    string
+		req     *updaterpb.UpdateRequest
+		ctx     context.Context
+		write   bool
+		token   string
+		tabs    []string
+		code    codes.Code
+		columns []string
+	}{
+		{
+			name: "reject missing group",
+			req:  &updaterpb.UpdateRequest{},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "reject paths",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: &configpb.TestGroup{Name: "../../x"},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "reject unknown group",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: &configpb.TestGroup{Name: "unknown"},
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "reject missing tab",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+				DashboardTabIdentifiers: []*updaterpb.DashboardTabIdentifier{
+					{DashboardName: "dash"},
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "reject unknown tab",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+				DashboardTabIdentifiers: []*updaterpb.DashboardTabIdentifier{
+					{DashboardName: "dash", DashboardTab: &configpb.DashboardTab{Name: "unknown"}},
+				},
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "reject tabs of other groups",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+				DashboardTabIdentifiers: []*updaterpb.DashboardTabIdentifier{
+					{DashboardName: "dash", DashboardTab: &configpb.DashboardTab{Name: "other-tab"}},
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "reject writes without a server token",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+			},
+			ctx:   authorized,
+			write: true,
+			code:  codes.PermissionDenied,
+		},
+		{
+			name: "reject writes without the token",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+			},
+			write: true,
+			token: token,
+			code:  codes.Unauthenticated,
+		},
+		{
+			name: "update group",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+			},
+			ctx:     authorized,
+			write:   true,
+			token:   token,
+			columns: []string{"2", "1"},
+		},
+		{
+			name: "ignore everything but the group name",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: &configpb.TestGroup{Name: "hello", GcsPrefix: "elsewhere/logs"},
+			},
+			ctx:     authorized,
+			write:   true,
+			token:   token,
+			columns: []string{"2", "1"},
+		},
+		{
+			name: "update group and summarize tabs",
+			req: &updaterpb.UpdateRequest{
+				TestGroup: group,
+				DashboardTabIdentifiers: []*updaterpb.DashboardTabIdentifier{
+					{DashboardName: "dash", DashboardTab: &configpb.DashboardTab{Name: "hello-tab"}},
+				},
+			},
+			ctx:     authorized,
+			write:   true,
+			token:   token,
+			tabs:    []string{"dash/hello-tab"},
+			columns: []string{"2", "1"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewServer(client, nil, *resolveOrDie(&root, "config"), "grid", 1, tc.write, tc.token, time.Minute, time.Minute)
+			reqCtx := tc.ctx
+			if reqCtx == nil {
+				reqCtx = ctx
+			}
+			resp, err := s.Update(reqCtx, tc.req)
+			switch {
+			case status.Code(err) != tc.code:
+				t.Fatalf("Update() got error %v, want code %s", err, tc.code)
+			case err != nil:
+				return
+			}
+			var tabs []string
+			for _, sum := range resp.DashboardTabSummaries {
+				if sum.Alert == "failed to summarize tab" {
+					t.Errorf("Update() failed to summarize %s/%s", sum.DashboardName, sum.DashboardTabName)
+				}
+				tabs = append(tabs, sum.DashboardName+"/"+sum.DashboardTabName)
+			}
+			if diff := cmp.Diff(tabs, tc.tabs); diff != "" {
+				t.Errorf("Update() summarized unexpected tabs (-have, +want):\n%s", diff)
+			}
+			if resp.UpdateEntry == nil {
+				t.Error("Update() returned no update entry")
+			}
+			grid, err := downloadGrid(ctx, client, *resolveOrDie(&root, "grid/hello"))
+			if err != nil {
+				t.Fatalf("downloadGrid() got unexpected error: %v", err)
+			}
+			var builds []string
+			for _, col := range grid.Columns {
+				builds = append(builds, col.Build)
+			}
+			if diff := cmp.Diff(builds, tc.columns); diff != "" {
+				t.Errorf("Update() wrote unexpected columns (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"time"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+// maxUpdateInfo is the number of update cycles to remember in Grid.update_info.
+const maxUpdateInfo = 10
+
+// timeNow returns the current time, and is replaced by tests.
+var timeNow = time.Now
+
+// phaseTimer records how long each phase of an update takes.
+type phaseTimer struct {
+	start  time.Time
+	last   time.Time
+	phases []*statepb.UpdatePhaseData
+}
+
+func newPhaseTimer() *phaseTimer {
+	now := timeNow()
+	return &phaseTimer{start: now, last: now}
+}
+
+// done records the time since the previous phase finished as the named phase.
+func (p *phaseTimer) done(name string) {
+	now := timeNow()
+	p.phases = append(p.phases, &statepb.UpdatePhaseData{
+		PhaseName:    name,
+		PhaseSeconds: now.Sub(p.last).Seconds(),
+	})
+	p.last = now
+}
+
+// info returns the phases recorded so far.
+func (p *phaseTimer) info() *statepb.UpdateInfo {
+	return &statepb.UpdateInfo{
+		UpdatePhaseData: append([]*statepb.UpdatePhaseData(nil), p.phases...),
+	}
+}
+
+// elapsed returns the time since the timer started until the latest phase finished.
+func (p *phaseTimer) elapsed() time.Duration {
+	return p.last.Sub(p.start)
+}
+
+// fields returns the duration of each phase for logging.
+func (p *phaseTimer) fields() map[string]interface{} {
+	out := make(map[string]interface{}, len(p.phases))
+	for _, phase := range p.phases {
+		out[phase.PhaseName] = time.Duration(phase.PhaseSeconds * float64(time.Second)).Round(time.Millisecond)
+	}
+	return out
+}
+
+// recordUpdate adds the current update to the grid's bounded history of update cycles, most recent first.
+func recordUpdate(grid, old *statepb.Grid, info *statepb.UpdateInfo, when time.Time) {
+	history := append([]*statepb.UpdateInfo{info}, old.GetUpdateInfo()...)
+	if len(history) > maxUpdateInfo {
+		history = history[:maxUpdateInfo]
+	}
+	grid.UpdateInfo = history
+	grid.LastTimeUpdated = float64(when.UnixNano()) / float64(time.Second)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+// phaseInfo returns update info for the named phases, each taking no time.
+func phaseInfo(names ...string) *statepb.UpdateInfo {
+	var info statepb.UpdateInfo
+	for _, name := range names {
+		info.UpdatePhaseData = append(info.UpdatePhaseData, &statepb.UpdatePhaseData{PhaseName: name})
+	}
+	return &info
+}
+
+func TestPhaseTimer(t *testing.T) {
+	defer func(orig func() time.Time) { timeNow = orig }(timeNow)
+	now := time.Unix(100, 0)
+	timeNow = func() time.Time { return now }
+
+	timer := newPhaseTimer()
+	now = now.Add(2 * time.Second)
+	timer.done("first")
+	now = now.Add(500 * time.Millisecond)
+	timer.done("second")
+
+	expected := &statepb.UpdateInfo{
+		UpdatePhaseData: []*statepb.UpdatePhaseData{
+			{PhaseName: "first", PhaseSeconds: 2},
+			{PhaseName: "second", PhaseSeconds: 0.5},
+		},
+	}
+	if diff := cmp.Diff(timer.info(), expected, protocmp.Transform()); diff != "" {
+		t.Errorf("info() got unexpected diff (-have, +want):\n%s", diff)
+	}
+	if actual, want := timer.elapsed(), 2500*time.Millisecond; actual != want {
+		t.Errorf("elapsed() got %s, want %s", actual, want)
+	}
+	fields := map[string]interface{}{
+		"first":  2 * time.Second,
+		"second": 500 * time.Millisecond,
+	}
+	if diff := cmp.Diff(timer.fields(), fields); diff != "" {
+		t.Errorf("fields() got unexpected diff (-have, +want):\n%s", diff)
+	}
+}
+
+func TestRecordUpdate(t *testing.T) {
+	history := func(n int) []*statepb.UpdateInfo {
+		var out []*statepb.UpdateInfo
+		for i := 0; i < n; i++ {
+			out = append(out, phaseInfo(fmt.Sprintf("old-%d", i)))
+		}
+		return out
+	}
+	cases := []struct {
+		name     string
+		old      *statepb.Grid
+		expected []*statepb.UpdateInfo
+	}{
+		{
+			name:     "no old grid",
+			expected: []*statepb.UpdateInfo{phaseInfo("new")},
+		},
+		{
+			name:     "prepend to history",
+			old:      &statepb.Grid{UpdateInfo: history(2)},
+			expected: append([]*statepb.UpdateInfo{phaseInfo("new")}, history(2)...),
+		},
+		{
+			name:     "drop oldest entries",
+			old:      &statepb.Grid{UpdateInfo: history(maxUpdateInfo)},
+			expected: append([]*statepb.UpdateInfo{phaseInfo("new")}, history(maxUpdateInfo-1)...),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var grid statepb.Grid
+			recordUpdate(&grid, tc.old, phaseInfo("new"), time.Unix(123, int64(500*time.Millisecond)))
+			if diff := cmp.Diff(grid.UpdateInfo, tc.expected, protocmp.Transform()); diff != "" {
+				t.Errorf("recordUpdate() got unexpected diff (-have, +want):\n%s", diff)
+			}
+			if grid.LastTimeUpdated != 123.5 {
+				t.Errorf("recordUpdate() got last_time_updated %f, want 123.5", grid.LastTimeUpdated)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"path"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,10 +42,12 @@ import (
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	updaterpb "github.com/GoogleCloudPlatform/testgrid/pb/updater"
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 )
 
-func Update(client gcs.Client, parent context.Context, configPath gcs.Path, gridPrefix string, groupConcurrency int, buildConcurrency int, confirm bool, groupTimeout time.Duration, buildTimeout time.Duration, group string) error {
+func Update(parent context.Context, client gcs.Client, rsClient *resultstore.Client, configPath gcs.Path, gridPrefix string, groupConcurrency int, buildConcurrency int, confirm bool, groupTimeout time.Duration, buildTimeout time.Duration, group string) error {
 	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 	log := logrus.WithField("config", configPath)
@@ -64,7 +67,7 @@ func Update(client gcs.Client, parent context.Context, configPath gcs.Path, grid
 				location := path.Join(gridPrefix, tg.Name)
 				tgp, err := testGroupPath(configPath, location)
 				if err == nil {
-					err = updateGroup(ctx, client, tg, *tgp, buildConcurrency, confirm, groupTimeout, buildTimeout)
+					_, err = updateGroup(ctx, client, rsClient, tg, *tgp, buildConcurrency, confirm, groupTimeout, buildTimeout)
 				}
 				if err != nil {
 					log.WithField("group", tg.Name).WithError(err).Error("Error updating group")
@@ -143,8 +146,15 @@ func logUpdate(ch <-chan int, total int, msg string) {
 	}
 }
 
+// groupPath returns the path to the test group's results.
+//
+// A gcs_prefix without a scheme, such as bucket/path/to/job, is a GCS path.
 func groupPath(tg configpb.TestGroup) (*gcs.Path, error) {
-	u, err := url.Parse("gs://" + tg.GcsPrefix)
+	prefix := tg.GcsPrefix
+	if !strings.Contains(prefix, "://") {
+		prefix = "gs://" + prefix
+	}
+	u, err := url.Parse(prefix)
 	if err != nil {
 		return nil, fmt.Errorf("parse: %w", err)
 	}
@@ -176,14 +186,30 @@ func truncateRunning(cols []inflatedColumn) []inflatedColumn {
 	return cols[stillRunning:]
 }
 
-func updateGroup(parent context.Context, client gcs.Client, tg configpb.TestGroup, gridPath gcs.Path, concurrency int, write bool, groupTimeout, buildTimeout time.Duration) error {
+// updateGroup updates the grid state of the test group, returning how long each phase of the update took.
+//
+// The grid records the phases through constructing the grid in its update_info history,
+// while the response also includes marshaling and uploading the grid.
+//
+// Groups with a resultstore_config read invocations from the rsClient instead of builds in GCS.
+func updateGroup(parent context.Context, client gcs.Client, rsClient *resultstore.Client, tg configpb.TestGroup, gridPath gcs.Path, concurrency int, write bool, groupTimeout, buildTimeout time.Duration) (*updaterpb.UpdateResponse, error) {
 	ctx, cancel := context.WithTimeout(parent, groupTimeout)
 	defer cancel()
 	log := logrus.WithField("group", tg.Name)
-
-	tgPath, err := groupPath(tg)
-	if err != nil {
-		return fmt.Errorf("group path: %w", err)
+	timer := newPhaseTimer()
+
+	rsConfig := tg.GetResultSource().GetResultstoreConfig()
+	var tgPath *gcs.Path
+	var err error
+	if rsConfig != nil {
+		if rsClient == nil {
+			return nil, errors.New("resultstore_config requires a resultstore client")
+		}
+	} else {
+		tgPath, err = groupPath(tg)
+		if err != nil {
+			return nil, fmt.Errorf("group path: %w", err)
+		}
 	}
 
 	var dur time.Duration
@@ -192,9 +218,10 @@ func updateGroup(parent context.Context, client gcs.Client, tg configpb.TestGrou
 	} else {
 		dur = days(7)
 	}
-	const maxCols = 50
+	maxCols := columnBudget(tg)
 
-	stop := time.Now().Add(-dur)
+	earliest := time.Now().Add(-dur)
+	stop := earliest
 
 	var oldCols []inflatedColumn
 
@@ -203,14 +230,17 @@ func updateGroup(parent context.Context, client gcs.Client, tg configpb.TestGrou
 		log.WithField("path", gridPath).WithError(err).Error("Failed to download existing grid")
 	}
 	if old != nil {
-		oldCols = truncateRunning(inflateGrid(old, stop, time.Now().Add(-4*time.Hour)))
+		oldCols = truncateRunning(inflateGrid(old, stop, time.Now().Add(-rereadWindow(tg))))
 	}
+	timer.done("download")
 
 	var since *gcs.Path
 	if len(oldCols) > 0 {
-		since, err = tgPath.ResolveReference(&url.URL{Path: oldCols[0].column.Build})
-		if err != nil {
-			log.WithError(err).Warning("Failed to resolve offset")
+		if tgPath != nil {
+			since, err = tgPath.ResolveReference(&url.URL{Path: oldCols[0].column.Build})
+			if err != nil {
+				log.WithError(err).Warning("Failed to resolve offset")
+			}
 		}
 		newStop := time.Unix(int64(oldCols[0].column.Started/1000), 0)
 		if newStop.After(stop) {
@@ -223,24 +253,48 @@ func updateGroup(parent context.Context, client gcs.Client, tg configpb.TestGrou
 		}
 	}
 
-	builds, err := gcs.ListBuilds(ctx, client, *tgPath, since)
-	if err != nil {
-		return fmt.Errorf("list builds: %w", err)
-	}
-	log.WithField("total", len(builds)).Debug("Listed builds")
+	var newCols []inflatedColumn
+	if rsConfig != nil {
+		invs, err := listInvocations(ctx, rsClient, rsConfig, stop)
+		if err != nil {
+			return nil, fmt.Errorf("list invocations: %w", err)
+		}
+		log.WithField("total", len(invs)).Debug("Listed invocations")
+		timer.done("list")
 
-	newCols, err := readColumns(ctx, client, tg, builds, stop, maxCols, buildTimeout, concurrency)
-	if err != nil {
-		return fmt.Errorf("read columns: %w", err)
+		newCols, err = readInvocations(ctx, rsClient, tg, invs, maxCols, concurrency)
+		if err != nil {
+			return nil, fmt.Errorf("read invocations: %w", err)
+		}
+	} else {
+		builds, err := gcs.ListBuilds(ctx, client, *tgPath, since)
+		if err != nil {
+			return nil, fmt.Errorf("list builds: %w", err)
+		}
+		log.WithField("total", len(builds)).Debug("Listed builds")
+		timer.done("list")
+
+		newCols, err = readColumns(ctx, client, tg, builds, stop, maxCols, buildTimeout, concurrency)
+		if err != nil {
+			return nil, fmt.Errorf("read columns: %w", err)
+		}
 	}
+	timer.done("read")
 
-	cols := mergeColumns(newCols, oldCols)
+	cols := mergeColumns(newCols, oldCols, earliest)
+	timer.done("merge")
 
 	grid := constructGrid(tg, cols)
+	timer.done("construct")
+	clusterGrid(&grid, cols, len(newCols), old)
+	timer.done("cluster")
+
+	recordUpdate(&grid, old, timer.info(), timeNow())
 	buf, err := marshalGrid(grid)
 	if err != nil {
-		return fmt.Errorf("marshal grid: %w", err)
+		return nil, fmt.Errorf("marshal grid: %w", err)
 	}
+	timer.done("marshal")
 	log = log.WithField("url", gridPath).WithField("bytes", len(buf))
 	if !write {
 		log.Debug("Skipping write")
@@ -248,20 +302,31 @@ func updateGroup(parent context.Context, client gcs.Client, tg configpb.TestGrou
 		log.Debug("Writing")
 		// TODO(fejta): configurable cache value
 		if err := client.Upload(ctx, gridPath, buf, gcs.DefaultAcl, "no-cache"); err != nil {
-			return fmt.Errorf("upload: %w", err)
+			return nil, fmt.Errorf("upload: %w", err)
 		}
+		timer.done("upload")
 	}
 	log.WithFields(logrus.Fields{
-		"cols": len(grid.Columns),
-		"rows": len(grid.Rows),
+		"cols":   len(grid.Columns),
+		"rows":   len(grid.Rows),
+		"took":   timer.elapsed().Round(time.Millisecond),
+		"phases": timer.fields(),
 	}).Info("Wrote grid")
-	return nil
+	return &updaterpb.UpdateResponse{
+		UpdateTimeMillis: uint32(timer.elapsed() / time.Millisecond),
+		OutputSizeBytes:  uint32(len(buf)),
+		UpdateEntry:      timer.info(),
+	}, nil
 }
 
-// mergeColumns combines newCols and oldCols.
+// mergeColumns combines newCols and oldCols, pruning columns started before earliest.
 //
 // When old and new both contain a column, chooses the new column.
-func mergeColumns(newCols, oldCols []inflatedColumn) []inflatedColumn {
+func mergeColumns(newCols, oldCols []inflatedColumn, earliest time.Time) []inflatedColumn {
+	return pruneColumns(appendOldColumns(newCols, oldCols), earliest)
+}
+
+func appendOldColumns(newCols, oldCols []inflatedColumn) []inflatedColumn {
 	// accept all the new columns
 	out := append([]inflatedColumn{}, newCols...)
 	if len(out) == 0 {
@@ -279,6 +344,33 @@ func mergeColumns(newCols, oldCols []inflatedColumn) []inflatedColumn {
 	return out
 }
 
+// pruneColumns drops the columns started before earliest.
+//
+// Columns are sorted from newest to oldest.
+func pruneColumns(cols []inflatedColumn, earliest time.Time) []inflatedColumn {
+	stop := float64(earliest.Unix() * 1000)
+	for i, col := range cols {
+		if col.column.Started < stop {
+			return cols[:i]
+		}
+	}
+	return cols
+}
+
+// defaultColumnBudget is the number of columns to read each update when max_columns_per_update is unset.
+const defaultColumnBudget = 50
+
+// columnBudget returns the maximum number of new columns to read during an update.
+func columnBudget(tg configpb.TestGroup) int {
+	if n := int(tg.MaxColumnsPerUpdate); n > 0 {
+		return n
+	}
+	if n := int(tg.NumColumnsRecent); n > defaultColumnBudget {
+		return n
+	}
+	return defaultColumnBudget
+}
+
 // days converts days float into a time.Duration, assuming a 24 hour day.
 //
 // A day is not always 24 hours due to things like leap-seconds.
@@ -299,10 +391,11 @@ func constructGrid(group configpb.TestGroup, cols []inflatedColumn) statepb.Grid
 	if failsOpen > 0 && passesClose == 0 {
 		passesClose = 1
 	}
+	commitIdx := commitIndex(group.ColumnHeader)
 
 	for _, col := range cols {
 		appendColumn(&grid, rows, col)
-		alertRows(grid.Columns, grid.Rows, failsOpen, passesClose)
+		alertRows(grid.Columns, grid.Rows, failsOpen, passesClose, commitIdx)
 	}
 	sort.SliceStable(grid.Rows, func(i, j int) bool {
 		return sortorder.NaturalLess(grid.Rows[i].Name, grid.Rows[j].Name)
@@ -399,6 +492,13 @@ func appendCell(row *statepb.Row, cell cell, count int) {
 		// Javascript client expects no result cells to skip icons/messages
 		row.Messages = append(row.Messages, cell.message)
 		row.Icons = append(row.Icons, cell.icon)
+		if cell.userProperty != "" || len(row.UserProperty) > 0 {
+			// Omit user properties until the first value, then align them with messages.
+			for len(row.UserProperty) < len(row.Messages)-1 {
+				row.UserProperty = append(row.UserProperty, "")
+			}
+			row.UserProperty = append(row.UserProperty, cell.userProperty)
+		}
 	}
 }
 
@@ -424,6 +524,78 @@ func makeNameConfig(tnc *configpb.TestNameConfig) nameConfig {
 	return nc
 }
 
+// groupOptions holds the test group settings that change how results are converted.
+type groupOptions struct {
+	rules        []rule
+	flaky        bool // collapse repeated attempts of a test into one cell
+	methods      methodOptions
+	pending      bool // ignore builds that have not finished
+	built        bool // ignore BUILD_PASSED cells
+	skip         bool // ignore PASS_WITH_SKIPS cells
+	oldResults   bool // keep only the last result of tests with the same name
+	maxRuntime   time.Duration
+	commit       commitOptions
+	shortText    string // show this metric in place of the icon
+	metrics      metricOptions
+	annotations  []*configpb.TestGroup_TestAnnotation
+	userProperty string // attach the value of this property to each cell
+}
+
+func makeGroupOptions(tg configpb.TestGroup) (groupOptions, error) {
+	methods, err := makeMethodOptions(tg)
+	if err != nil {
+		return groupOptions{}, err
+	}
+	commit, err := makeCommitOptions(tg)
+	if err != nil {
+		return groupOptions{}, err
+	}
+	rules, err := makeRules(tg.GetCustomEvaluatorRuleSet().GetRules())
+	if err != nil {
+		return groupOptions{}, fmt.Errorf("custom_evaluator_rule_set: %w", err)
+	}
+	return groupOptions{
+		rules:        rules,
+		flaky:        tg.EnableFlakyStatus,
+		methods:      methods,
+		pending:      tg.IgnorePending,
+		built:        tg.IgnoreBuilt,
+		skip:         tg.IgnoreSkip,
+		oldResults:   tg.IgnoreOldResults,
+		maxRuntime:   maxRuntime(tg),
+		commit:       commit,
+		shortText:    tg.ShortTextMetric,
+		metrics:      makeMetricOptions(tg),
+		annotations:  tg.TestAnnotations,
+		userProperty: tg.UserProperty,
+	}, nil
+}
+
+const (
+	// defaultMaxRuntime is how long a build may run when max_test_runtime_hours is unset.
+	defaultMaxRuntime = 24 * time.Hour
+	// defaultReread is how long to re-read builds when max_test_runtime_hours is unset.
+	defaultReread = 4 * time.Hour
+)
+
+// maxRuntime returns how long a build may run before it times out.
+func maxRuntime(tg configpb.TestGroup) time.Duration {
+	if tg.MaxTestRuntimeHours > 0 {
+		return time.Duration(tg.MaxTestRuntimeHours) * time.Hour
+	}
+	return defaultMaxRuntime
+}
+
+// rereadWindow returns how long builds may still change after they start.
+//
+// Updates read builds started within this window again.
+func rereadWindow(tg configpb.TestGroup) time.Duration {
+	if tg.MaxTestRuntimeHours > 0 {
+		return time.Duration(tg.MaxTestRuntimeHours) * time.Hour
+	}
+	return defaultReread
+}
+
 // appendColumn adds the build column to the grid.
 //
 // This handles details like:
@@ -463,14 +635,16 @@ func appendColumn(grid *statepb.Grid, rows map[string]*statepb.Row, inflated inf
 }
 
 // alertRows configures the alert for every row that has one.
-func alertRows(cols []*statepb.Column, rows []*statepb.Row, openFailures, closePasses int) {
+//
+// Alerts identify builds by the value of the Commit header at commitIdx, if any.
+func alertRows(cols []*statepb.Column, rows []*statepb.Row, openFailures, closePasses, commitIdx int) {
 	for _, r := range rows {
-		r.AlertInfo = alertRow(cols, r, openFailures, closePasses)
+		r.AlertInfo = alertRow(cols, r, openFailures, closePasses, commitIdx)
 	}
 }
 
 // alertRow returns an AlertInfo proto if there have been failuresToOpen consecutive failures more recently than passesToClose.
-func alertRow(cols []*statepb.Column, row *statepb.Row, failuresToOpen, passesToClose int) *statepb.AlertInfo {
+func alertRow(cols []*statepb.Column, row *statepb.Row, failuresToOpen, passesToClose, commitIdx int) *statepb.AlertInfo {
 	if failuresToOpen == 0 {
 		return nil
 	}
@@ -530,29 +704,47 @@ func alertRow(cols []*statepb.Column, row *statepb.Row, failuresToOpen, passesTo
 	}
 	msg := row.Messages[failIdx]
 	id := row.CellIds[failIdx]
-	return alertInfo(totalFailures, msg, id, lastFail, latestPass)
+	return alertInfo(totalFailures, msg, id, lastFail, latestPass, commitIdx)
 }
 
 // alertInfo returns an alert proto with the configured fields
-func alertInfo(failures int32, msg, cellID string, fail, pass *statepb.Column) *statepb.AlertInfo {
+func alertInfo(failures int32, msg, cellID string, fail, pass *statepb.Column, commitIdx int) *statepb.AlertInfo {
 	return &statepb.AlertInfo{
 		FailCount:      failures,
-		FailBuildId:    buildID(fail),
+		FailBuildId:    buildID(fail, commitIdx),
 		FailTime:       stamp(fail),
 		FailTestId:     cellID,
 		FailureMessage: msg,
 		PassTime:       stamp(pass),
-		PassBuildId:    buildID(pass),
+		PassBuildId:    buildID(pass, commitIdx),
+	}
+}
+
+// commitIndex returns the index of the Commit column header, or -1 if there is none.
+func commitIndex(headers []*configpb.TestGroup_ColumnHeader) int {
+	for i, h := range headers {
+		if h.ConfigurationValue == commitHeader {
+			return i
+		}
 	}
+	return -1
 }
 
-// buildID extracts the ID from the first extra row or else the Build field.
-func buildID(col *statepb.Column) string {
+// buildID extracts the ID from the Commit header at commitIdx or else the Build field.
+//
+// Without a Commit header (a negative commitIdx), uses the first extra row if any.
+func buildID(col *statepb.Column, commitIdx int) string {
 	if col == nil {
 		return ""
 	}
-	if len(col.Extra) > 0 {
-		return col.Extra[0]
+	if commitIdx < 0 {
+		if len(col.Extra) > 0 {
+			return col.Extra[0]
+		}
+		return col.Build
+	}
+	if commitIdx < len(col.Extra) && col.Extra[commitIdx] != "" {
+		return col.Extra[commitIdx]
 	}
 	return col.Build
 }
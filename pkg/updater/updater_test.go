@@ -19,6 +19,9 @@ package updater
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
@@ -42,6 +45,8 @@ import (
 
 func TestUpdate(t *testing.T) {
 	defaultTimeout := 5 * time.Minute
+	defer func(orig func() time.Time) { timeNow = orig }(timeNow)
+	timeNow = func() time.Time { return time.Unix(1000, 0) }
 	configPath := newPathOrDie("gs://bucket/path/to/config")
 	cases := []struct {
 		name             string
@@ -85,7 +90,12 @@ func TestUpdate(t *testing.T) {
 			},
 			expected: fakeUploader{
 				*resolveOrDie(&configPath, "hello"): {
-					buf:          mustGrid(statepb.Grid{}),
+					buf: mustGrid(statepb.Grid{
+						UpdateInfo: []*statepb.UpdateInfo{
+							phaseInfo("download", "list", "read", "merge", "construct", "cluster"),
+						},
+						LastTimeUpdated: 1000,
+					}),
 					cacheControl: "no-cache",
 					worldRead:    gcs.DefaultAcl,
 				},
@@ -150,8 +160,9 @@ func TestUpdate(t *testing.T) {
 			}
 
 			err := Update(
-				client,
 				ctx,
+				client,
+				nil,
 				configPath,
 				tc.gridPrefix,
 				tc.groupConcurrency,
@@ -180,6 +191,124 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateLocal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "updater")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	root := newPathOrDie("file://" + filepath.ToSlash(dir) + "/")
+	client := gcs.NewLocalClient()
+	ctx := context.Background()
+
+	upload := func(name string, data string) {
+		if err := client.Upload(ctx, *resolveOrDie(&root, name), []byte(data), gcs.DefaultAcl, ""); err != nil {
+			t.Fatalf("Failed to upload %s: %v", name, err)
+		}
+	}
+	cfg := configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{
+				Name:             "hello",
+				GcsPrefix:        resolveOrDie(&root, "logs/hello").String(),
+				DaysOfResults:    7,
+				NumColumnsRecent: 6,
+			},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{
+						Name:          "hello-tab",
+						TestGroupName: "hello",
+					},
+				},
+			},
+		},
+	}
+	buf, err := config.MarshalBytes(&cfg)
+	if err != nil {
+		t.Fatalf("config.MarshalBytes() errored: %v", err)
+	}
+	upload("config", string(buf))
+	now := time.Now().Unix()
+	for i, build := range []string{"1", "2"} {
+		upload("logs/hello/"+build+"/started.json", jsonStarted(now+int64(i)).data)
+		upload("logs/hello/"+build+"/finished.json", jsonFinished(now+int64(i)+1, true, nil).data)
+		upload("logs/hello/"+build+"/artifacts/junit.xml", makeJunit([]string{"good"}, []string{"bad"}))
+	}
+
+	configPath := *resolveOrDie(&root, "config")
+	if err := Update(ctx, client, nil, configPath, "grid", 1, 1, true, time.Minute, time.Minute, ""); err != nil {
+		t.Fatalf("Update() got unexpected error: %v", err)
+	}
+
+	grid, err := downloadGrid(ctx, client, *resolveOrDie(&root, "grid/hello"))
+	if err != nil {
+		t.Fatalf("downloadGrid() got unexpected error: %v", err)
+	}
+	var builds, rows []string
+	for _, col := range grid.Columns {
+		builds = append(builds, col.Build)
+	}
+	for _, row := range grid.Rows {
+		rows = append(rows, row.Name)
+	}
+	if diff := cmp.Diff(builds, []string{"2", "1"}); diff != "" {
+		t.Errorf("Update() wrote unexpected columns (-have, +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(rows, []string{"Overall", "bad", "good"}); diff != "" {
+		t.Errorf("Update() wrote unexpected rows (-have, +want):\n%s", diff)
+	}
+}
+
+func TestGroupPath(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefix   string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "bucket paths default to gs://",
+			prefix:   "bucket/path/to/job",
+			expected: "gs://bucket/path/to/job/",
+		},
+		{
+			name:     "gs:// urls work",
+			prefix:   "gs://bucket/path/to/job/",
+			expected: "gs://bucket/path/to/job/",
+		},
+		{
+			name:     "file:// urls work",
+			prefix:   "file:///path/to/job",
+			expected: "file:///path/to/job/",
+		},
+		{
+			name:   "reject other schemes",
+			prefix: "http://example.com/job",
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := groupPath(configpb.TestGroup{GcsPrefix: tc.prefix})
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("groupPath() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Errorf("groupPath() failed to return an error")
+			case actual.String() != tc.expected:
+				t.Errorf("groupPath() got %s, want %s", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func TestTestGroupPath(t *testing.T) {
 	path := newPathOrDie("gs://bucket/config")
 	pNewPathOrDie := func(s string) *gcs.Path {
@@ -375,6 +504,8 @@ func TestTruncateRunning(t *testing.T) {
 
 func TestUpdateGroup(t *testing.T) {
 	now := time.Now().Unix()
+	defer func(orig func() time.Time) { timeNow = orig }(timeNow)
+	timeNow = func() time.Time { return time.Unix(now+100, 0) }
 	uploadPath := newPathOrDie("gs://fake/upload/location")
 	defaultTimeout := 5 * time.Minute
 	cases := []struct {
@@ -387,6 +518,7 @@ func TestUpdateGroup(t *testing.T) {
 		groupTimeout *time.Duration
 		buildTimeout *time.Duration
 		expected     *fakeUpload
+		phases       []string
 		err          bool
 	}{
 		{
@@ -513,10 +645,31 @@ func TestUpdateGroup(t *testing.T) {
 							cell{result: statuspb.TestStatus_PASS},
 						),
 					},
+					Cluster: []*statepb.Cluster{
+						{
+							TestStatus: int32(statuspb.TestStatus_FAIL),
+							ClusterRow: []*statepb.ClusterRow{
+								{DisplayName: "Overall", Index: []int32{2}},
+							},
+						},
+						{
+							TestStatus: int32(statuspb.TestStatus_FAIL),
+							Message:    "flaky",
+							ClusterRow: []*statepb.ClusterRow{
+								{DisplayName: "flaky", Index: []int32{2}},
+							},
+						},
+					},
+					MostRecentClusterTimestamp: float64(now+99) * 1000,
+					UpdateInfo: []*statepb.UpdateInfo{
+						phaseInfo("download", "list", "read", "merge", "construct", "cluster"),
+					},
+					LastTimeUpdated: float64(now + 100),
 				}),
 				cacheControl: "no-cache",
 				worldRead:    gcs.DefaultAcl,
 			},
+			phases: []string{"download", "list", "read", "merge", "construct", "cluster", "marshal", "upload"},
 		},
 		{
 			name:      "do not write when requested",
@@ -560,6 +713,7 @@ func TestUpdateGroup(t *testing.T) {
 					passed: []string{"good1", "good2", "flaky"},
 				},
 			},
+			phases: []string{"download", "list", "read", "merge", "construct", "cluster", "marshal"},
 		},
 	}
 
@@ -598,9 +752,10 @@ func TestUpdateGroup(t *testing.T) {
 			}
 			client.fakeLister[buildsPath] = fi
 
-			err := updateGroup(
+			resp, err := updateGroup(
 				ctx,
 				client,
+				nil,
 				tc.group,
 				uploadPath,
 				tc.concurrency,
@@ -616,6 +771,9 @@ func TestUpdateGroup(t *testing.T) {
 			case tc.err:
 				t.Error("updateGroup() failed to receive an exception")
 			default:
+				if diff := cmp.Diff(resp.UpdateEntry, phaseInfo(tc.phases...), protocmp.Transform()); diff != "" {
+					t.Errorf("updateGroup() got unexpected phase diff (-have, +want):\n%s", diff)
+				}
 				expected := fakeUploader{}
 				if tc.expected != nil {
 					expected[uploadPath] = *tc.expected
@@ -649,10 +807,12 @@ func TestUpdateGroup(t *testing.T) {
 }
 
 func TestMergeColumns(t *testing.T) {
+	now := time.Now()
 	cases := []struct {
 		name     string
 		newCols  []inflatedColumn
 		oldCols  []inflatedColumn
+		earliest time.Time
 		expected []inflatedColumn
 	}{
 		{
@@ -1117,11 +1277,108 @@ func TestMergeColumns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "prune columns older than earliest",
+			newCols: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "new",
+						Started: 5000,
+					},
+				},
+			},
+			oldCols: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "recent",
+						Started: 4000,
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "edge",
+						Started: 3000,
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "expired",
+						Started: 2000,
+					},
+				},
+			},
+			earliest: time.Unix(3, 0),
+			expected: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "new",
+						Started: 5000,
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "recent",
+						Started: 4000,
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "edge",
+						Started: 3000,
+					},
+				},
+			},
+		},
+		{
+			name: "low frequency groups keep old columns within days of results",
+			oldCols: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "yesterday",
+						Started: float64(now.Add(-24*time.Hour).Unix() * 1000),
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "last week",
+						Started: float64(now.Add(-7*24*time.Hour).Unix() * 1000),
+					},
+				},
+			},
+			earliest: now.Add(-14 * 24 * time.Hour),
+			expected: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "yesterday",
+						Started: float64(now.Add(-24*time.Hour).Unix() * 1000),
+					},
+				},
+				{
+					column: &statepb.Column{
+						Build:   "last week",
+						Started: float64(now.Add(-7*24*time.Hour).Unix() * 1000),
+					},
+				},
+			},
+		},
+		{
+			name: "prune every expired column",
+			newCols: []inflatedColumn{
+				{
+					column: &statepb.Column{
+						Build:   "old",
+						Started: 1000,
+					},
+				},
+			},
+			earliest: now,
+			expected: []inflatedColumn{},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := mergeColumns(tc.newCols, tc.oldCols)
+			actual := mergeColumns(tc.newCols, tc.oldCols, tc.earliest)
 			internals := cmp.AllowUnexported(inflatedColumn{}, cell{})
 			if diff := cmp.Diff(actual, tc.expected, internals, protocmp.Transform()); diff != "" {
 				t.Errorf("mergeColumns() got unexpected diff (-have, +want):\n%s", diff)
@@ -1387,7 +1644,7 @@ func TestConstructGrid(t *testing.T) {
 			if failuresOpen > 0 && passesClose == 0 {
 				passesClose = 1
 			}
-			alertRows(tc.expected.Columns, tc.expected.Rows, failuresOpen, passesClose)
+			alertRows(tc.expected.Columns, tc.expected.Rows, failuresOpen, passesClose, commitIndex(tc.group.ColumnHeader))
 			for _, row := range tc.expected.Rows {
 				sort.SliceStable(row.Metric, func(i, j int) bool {
 					return sortorder.NaturalLess(row.Metric[i], row.Metric[j])
@@ -1633,6 +1890,58 @@ func TestAppendCell(t *testing.T) {
 				Icons:    []string{"", "", ""},
 			},
 		},
+		{
+			name: "first user property aligns with earlier cells",
+			row: statepb.Row{
+				Results: []int32{
+					int32(statuspb.TestStatus_PASS), 2,
+					int32(statuspb.TestStatus_NO_RESULT), 1,
+				},
+				CellIds:  []string{"", "", ""},
+				Messages: []string{"", ""},
+				Icons:    []string{"", ""},
+			},
+			cell: cell{
+				result:       statuspb.TestStatus_PASS,
+				userProperty: "node-1",
+			},
+			count: 1,
+			expected: statepb.Row{
+				Results: []int32{
+					int32(statuspb.TestStatus_PASS), 2,
+					int32(statuspb.TestStatus_NO_RESULT), 1,
+					int32(statuspb.TestStatus_PASS), 1,
+				},
+				CellIds:      []string{"", "", "", ""},
+				Messages:     []string{"", "", ""},
+				Icons:        []string{"", "", ""},
+				UserProperty: []string{"", "", "node-1"},
+			},
+		},
+		{
+			name: "append to user properties",
+			row: statepb.Row{
+				Results:      []int32{int32(statuspb.TestStatus_PASS), 1},
+				CellIds:      []string{""},
+				Messages:     []string{""},
+				Icons:        []string{""},
+				UserProperty: []string{"node-1"},
+			},
+			cell: cell{
+				result: statuspb.TestStatus_FAIL,
+			},
+			count: 2,
+			expected: statepb.Row{
+				Results: []int32{
+					int32(statuspb.TestStatus_PASS), 1,
+					int32(statuspb.TestStatus_FAIL), 2,
+				},
+				CellIds:      []string{"", "", ""},
+				Messages:     []string{"", "", ""},
+				Icons:        []string{"", "", ""},
+				UserProperty: []string{"node-1", "", ""},
+			},
+		},
 		{
 			name: "add metric to series",
 			row: statepb.Row{
@@ -1957,7 +2266,7 @@ func TestAlertRow(t *testing.T) {
 				CellIds:  []string{"yes", "no", "no again", "very wrong"},
 			},
 			failOpen: 3,
-			expected: alertInfo(3, "hello", "yes", columns[2], columns[3]),
+			expected: alertInfo(3, "hello", "yes", columns[2], columns[3], -1),
 		},
 		{
 			name: "too few passes do not close",
@@ -1971,7 +2280,7 @@ func TestAlertRow(t *testing.T) {
 			},
 			failOpen:  1,
 			passClose: 3,
-			expected:  alertInfo(4, "yay", "yep", columns[5], nil),
+			expected:  alertInfo(4, "yay", "yep", columns[5], nil, -1),
 		},
 		{
 			name: "flakes do not close",
@@ -1984,7 +2293,7 @@ func TestAlertRow(t *testing.T) {
 				CellIds:  []string{"wrong", "no", "yep", "very wrong"},
 			},
 			failOpen: 1,
-			expected: alertInfo(4, "yay", "yep", columns[5], nil),
+			expected: alertInfo(4, "yay", "yep", columns[5], nil, -1),
 		},
 		{
 			name: "count failures after flaky passes",
@@ -2001,7 +2310,7 @@ func TestAlertRow(t *testing.T) {
 			},
 			failOpen:  2,
 			passClose: 2,
-			expected:  alertInfo(4, "this one", "good job", columns[5], nil),
+			expected:  alertInfo(4, "this one", "good job", columns[5], nil, -1),
 		},
 		{
 			name: "close alert",
@@ -2026,7 +2335,7 @@ func TestAlertRow(t *testing.T) {
 			},
 			failOpen:  5,
 			passClose: 2,
-			expected:  alertInfo(5, "yay", "yay-cell", columns[5], nil),
+			expected:  alertInfo(5, "yay", "yay-cell", columns[5], nil, -1),
 		},
 		{
 			name: "track passes through empty results",
@@ -2052,12 +2361,12 @@ func TestAlertRow(t *testing.T) {
 				CellIds:  []string{"wrong", "yep", "no2", "no3", "no4", "no5"},
 			},
 			failOpen: 1,
-			expected: alertInfo(5, "fail1-expected", "yep", columns[5], nil),
+			expected: alertInfo(5, "fail1-expected", "yep", columns[5], nil, -1),
 		},
 	}
 
 	for _, tc := range cases {
-		if actual := alertRow(columns, &tc.row, tc.failOpen, tc.passClose); !reflect.DeepEqual(actual, tc.expected) {
+		if actual := alertRow(columns, &tc.row, tc.failOpen, tc.passClose, -1); !reflect.DeepEqual(actual, tc.expected) {
 			t.Errorf("%s alert %s != expected %s", tc.name, actual, tc.expected)
 		}
 	}
@@ -2065,24 +2374,49 @@ func TestAlertRow(t *testing.T) {
 
 func TestBuildID(t *testing.T) {
 	cases := []struct {
-		name     string
-		build    string
-		extra    string
-		expected string
+		name      string
+		build     string
+		extra     []string
+		commitIdx int
+		expected  string
 	}{
 		{
-			name: "return empty by default",
+			name:      "return empty by default",
+			commitIdx: -1,
 		},
 		{
-			name:     "favor extra if it exists",
-			build:    "wrong",
-			extra:    "right",
-			expected: "right",
+			name:      "favor extra if it exists",
+			build:     "wrong",
+			extra:     []string{"right"},
+			commitIdx: -1,
+			expected:  "right",
 		},
 		{
-			name:     "build if no extra",
-			build:    "yes",
-			expected: "yes",
+			name:      "build if no extra",
+			build:     "yes",
+			commitIdx: -1,
+			expected:  "yes",
+		},
+		{
+			name:      "favor the commit header if it exists",
+			build:     "wrong",
+			extra:     []string{"other", "right"},
+			commitIdx: 1,
+			expected:  "right",
+		},
+		{
+			name:      "build if the commit header is empty",
+			build:     "yes",
+			extra:     []string{"other", ""},
+			commitIdx: 1,
+			expected:  "yes",
+		},
+		{
+			name:      "build if the column lacks the header",
+			build:     "yes",
+			extra:     []string{"other"},
+			commitIdx: 1,
+			expected:  "yes",
 		},
 	}
 
@@ -2090,17 +2424,52 @@ func TestBuildID(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			col := statepb.Column{
 				Build: tc.build,
+				Extra: tc.extra,
 			}
-			if tc.extra != "" {
-				col.Extra = append(col.Extra, tc.extra)
-			}
-			if actual := buildID(&col); actual != tc.expected {
+			if actual := buildID(&col, tc.commitIdx); actual != tc.expected {
 				t.Errorf("%q != expected %q", actual, tc.expected)
 			}
 		})
 	}
 }
 
+func TestCommitIndex(t *testing.T) {
+	cases := []struct {
+		name     string
+		headers  []*configpb.TestGroup_ColumnHeader
+		expected int
+	}{
+		{
+			name:     "no headers",
+			expected: -1,
+		},
+		{
+			name: "no commit header",
+			headers: []*configpb.TestGroup_ColumnHeader{
+				{ConfigurationValue: "node_os_image"},
+				{Label: "Commit"},
+			},
+			expected: -1,
+		},
+		{
+			name: "find commit header",
+			headers: []*configpb.TestGroup_ColumnHeader{
+				{ConfigurationValue: "node_os_image"},
+				{ConfigurationValue: "Commit"},
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := commitIndex(tc.headers); actual != tc.expected {
+				t.Errorf("commitIndex() got %d, want %d", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func TestStamp(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -2140,3 +2509,94 @@ func TestStamp(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxRuntime(t *testing.T) {
+	cases := []struct {
+		name    string
+		hours   int32
+		runtime time.Duration
+		reread  time.Duration
+	}{
+		{
+			name:    "defaults",
+			runtime: 24 * time.Hour,
+			reread:  4 * time.Hour,
+		},
+		{
+			name:    "short jobs",
+			hours:   1,
+			runtime: time.Hour,
+			reread:  time.Hour,
+		},
+		{
+			name:    "soak jobs",
+			hours:   72,
+			runtime: 72 * time.Hour,
+			reread:  72 * time.Hour,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tg := configpb.TestGroup{MaxTestRuntimeHours: tc.hours}
+			if actual := maxRuntime(tg); actual != tc.runtime {
+				t.Errorf("maxRuntime() got %s, want %s", actual, tc.runtime)
+			}
+			if actual := rereadWindow(tg); actual != tc.reread {
+				t.Errorf("rereadWindow() got %s, want %s", actual, tc.reread)
+			}
+		})
+	}
+}
+
+func TestColumnBudget(t *testing.T) {
+	cases := []struct {
+		name     string
+		group    configpb.TestGroup
+		expected int
+	}{
+		{
+			name:     "default",
+			expected: 50,
+		},
+		{
+			name: "low frequency groups use the default",
+			group: configpb.TestGroup{
+				DaysOfResults:    30,
+				NumColumnsRecent: 10,
+			},
+			expected: 50,
+		},
+		{
+			name: "show more recent columns",
+			group: configpb.TestGroup{
+				NumColumnsRecent: 200,
+			},
+			expected: 200,
+		},
+		{
+			name: "high frequency groups configure a larger budget",
+			group: configpb.TestGroup{
+				DaysOfResults:       14,
+				NumColumnsRecent:    200,
+				MaxColumnsPerUpdate: 1000,
+			},
+			expected: 1000,
+		},
+		{
+			name: "configure a smaller budget",
+			group: configpb.TestGroup{
+				MaxColumnsPerUpdate: 5,
+			},
+			expected: 5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := columnBudget(tc.group); actual != tc.expected {
+				t.Errorf("columnBudget() got %d, want %d", actual, tc.expected)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
 	resultstore "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc"
@@ -77,10 +78,11 @@ func NewSecret() Secret {
 
 // Client provides ResultStore CRUD methods.
 type Client struct {
-	up    resultstore.ResultStoreUploadClient
-	down  resultstore.ResultStoreDownloadClient
-	ctx   context.Context
-	token string
+	up       resultstore.ResultStoreUploadClient
+	down     resultstore.ResultStoreDownloadClient
+	ctx      context.Context
+	token    string
+	pageSize int32
 }
 
 // NewClient uses the specified gRPC connection to connect to ResultStore.
@@ -104,6 +106,14 @@ func (c *Client) WithSecret(authorizationToken Secret) *Client {
 	return c
 }
 
+// WithPageSize requests at most this many results per page when listing or searching.
+//
+// ResultStore chooses the page size when zero.
+func (c *Client) WithPageSize(n int32) *Client {
+	c.pageSize = n
+	return c
+}
+
 // Access resources
 
 // Invocations provides Invocation CRUD methods.
@@ -206,19 +216,55 @@ func (t Targets) Create(id string, target Target) (string, error) {
 	return tgt.Name, nil
 }
 
-// List requested fields in targets, does not currently handle paging.
-func (t Targets) List(fields ...string) ([]Target, error) {
-	resp, err := t.down.ListTargets(listMask(t.ctx, fields...), &resultstore.ListTargetsRequest{
-		Parent: t.inv,
-	})
-	if err != nil {
-		return nil, err
+// TargetFields represent all target fields this client cares about.
+var TargetFields = [...]string{
+	"targets.name",
+	"targets.timing",
+	"targets.status_attributes",
+	"targets.target_attributes",
+	"targets.properties",
+}
+
+// Iterator streams the requested fields of each target, fetching each page as needed.
+func (t Targets) Iterator(fields ...string) *TargetIterator {
+	if len(fields) == 0 {
+		fields = TargetFields[:]
+	}
+	var it TargetIterator
+	it.fetch = func(token string) (string, error) {
+		req := resultstore.ListTargetsRequest{
+			Parent:   t.inv,
+			PageSize: t.pageSize,
+		}
+		if token != "" {
+			req.PageStart = &resultstore.ListTargetsRequest_PageToken{PageToken: token}
+		}
+		resp, err := t.down.ListTargets(listMask(t.ctx, fields...), &req)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range resp.Targets {
+			it.buf = append(it.buf, fromTarget(r))
+		}
+		return resp.NextPageToken, nil
 	}
+	return &it
+}
+
+// List requested fields in every page of targets.
+func (t Targets) List(fields ...string) ([]Target, error) {
+	it := t.Iterator(fields...)
 	var targets []Target
-	for _, r := range resp.Targets {
-		targets = append(targets, fromTarget(r))
+	for {
+		tgt, err := it.Next()
+		if err == iterator.Done {
+			return targets, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, *tgt)
 	}
-	return targets, nil
 }
 
 // Configuration methods
@@ -242,6 +288,55 @@ func (c Configurations) Create(id string) (string, error) {
 	return config.Name, nil
 }
 
+// ConfigurationFields represent all configuration fields this client cares about.
+var ConfigurationFields = [...]string{
+	"configurations.name",
+	"configurations.status_attributes",
+	"configurations.properties",
+}
+
+// Iterator streams the requested fields of each configuration, fetching each page as needed.
+func (c Configurations) Iterator(fields ...string) *ConfigurationIterator {
+	if len(fields) == 0 {
+		fields = ConfigurationFields[:]
+	}
+	var it ConfigurationIterator
+	it.fetch = func(token string) (string, error) {
+		req := resultstore.ListConfigurationsRequest{
+			Parent:   c.inv,
+			PageSize: c.pageSize,
+		}
+		if token != "" {
+			req.PageStart = &resultstore.ListConfigurationsRequest_PageToken{PageToken: token}
+		}
+		resp, err := c.down.ListConfigurations(listMask(c.ctx, fields...), &req)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range resp.Configurations {
+			it.buf = append(it.buf, fromConfiguration(r))
+		}
+		return resp.NextPageToken, nil
+	}
+	return &it
+}
+
+// List requested fields in every page of configurations.
+func (c Configurations) List(fields ...string) ([]Configuration, error) {
+	it := c.Iterator(fields...)
+	var configs []Configuration
+	for {
+		cfg, err := it.Next()
+		if err == iterator.Done {
+			return configs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, *cfg)
+	}
+}
+
 // ConfiguredTarget methods
 
 // Create a new configured target, returning the fully qualified path.
@@ -260,6 +355,58 @@ func (ct ConfiguredTargets) Create(act Action) (string, error) {
 	return resp.Name, nil
 }
 
+// ConfiguredTargetFields represent all configured target fields this client cares about.
+var ConfiguredTargetFields = [...]string{
+	"configuredTargets.name",
+	"configuredTargets.timing",
+	"configuredTargets.status_attributes",
+	"configuredTargets.properties",
+}
+
+// Iterator streams the requested fields of each configured target of the target, fetching each page as needed.
+//
+// Lists every configuration of the target, ignoring the config ID.
+func (ct ConfiguredTargets) Iterator(fields ...string) *ConfiguredTargetIterator {
+	if len(fields) == 0 {
+		fields = ConfiguredTargetFields[:]
+	}
+	var it ConfiguredTargetIterator
+	it.fetch = func(token string) (string, error) {
+		req := resultstore.ListConfiguredTargetsRequest{
+			Parent:   ct.target,
+			PageSize: ct.pageSize,
+		}
+		if token != "" {
+			req.PageStart = &resultstore.ListConfiguredTargetsRequest_PageToken{PageToken: token}
+		}
+		resp, err := ct.down.ListConfiguredTargets(listMask(ct.ctx, fields...), &req)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range resp.ConfiguredTargets {
+			it.buf = append(it.buf, fromConfiguredTarget(r))
+		}
+		return resp.NextPageToken, nil
+	}
+	return &it
+}
+
+// List requested fields in every page of configured targets.
+func (ct ConfiguredTargets) List(fields ...string) ([]ConfiguredTarget, error) {
+	it := ct.Iterator(fields...)
+	var cts []ConfiguredTarget
+	for {
+		c, err := it.Next()
+		if err == iterator.Done {
+			return cts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		cts = append(cts, *c)
+	}
+}
+
 // Action methods
 
 // Create a test action under the specified ID, returning the fully-qualified path.
@@ -286,22 +433,46 @@ var TestFields = [...]string{
 	"actions.timing",
 }
 
-// List tests in this configured target.
-func (a Actions) List(fields ...string) ([]Test, error) {
+// Iterator streams the tests in this configured target, fetching each page as needed.
+func (a Actions) Iterator(fields ...string) *TestIterator {
 	if len(fields) == 0 {
 		fields = TestFields[:]
 	}
-	resp, err := a.down.ListActions(listMask(a.ctx, fields...), &resultstore.ListActionsRequest{
-		Parent: a.configuredTarget,
-	})
-	if err != nil {
-		return nil, err
+	var it TestIterator
+	it.fetch = func(token string) (string, error) {
+		req := resultstore.ListActionsRequest{
+			Parent:   a.configuredTarget,
+			PageSize: a.pageSize,
+		}
+		if token != "" {
+			req.PageStart = &resultstore.ListActionsRequest_PageToken{PageToken: token}
+		}
+		resp, err := a.down.ListActions(listMask(a.ctx, fields...), &req)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range resp.Actions {
+			it.buf = append(it.buf, fromTest(r))
+		}
+		return resp.NextPageToken, nil
 	}
+	return &it
+}
+
+// List tests in every page of this configured target.
+func (a Actions) List(fields ...string) ([]Test, error) {
+	it := a.Iterator(fields...)
 	var ret []Test
-	for _, r := range resp.Actions {
-		ret = append(ret, fromTest(r))
+	for {
+		test, err := it.Next()
+		if err == iterator.Done {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *test)
 	}
-	return ret, nil
 }
 
 // Invocation methods
@@ -318,6 +489,23 @@ func (i Invocations) Create(inv Invocation) (string, error) {
 	return resp.Name, nil
 }
 
+// CreateWithID creates a new invocation with the specified ID (project must be specified), returning the fully qualified path.
+//
+// Creating the same ID again fails with an AlreadyExists error.
+func (i Invocations) CreateWithID(id string, inv Invocation) (string, error) {
+	rsi := inv.To()
+	rsi.Name = ""
+	resp, err := i.up.CreateInvocation(i.ctx, &resultstore.CreateInvocationRequest{
+		InvocationId:       id,
+		Invocation:         rsi,
+		AuthorizationToken: i.token,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Name, nil
+}
+
 // Update a pre-existing invocation at name.
 func (i Invocations) Update(inv Invocation, fields ...string) error {
 	_, err := i.up.UpdateInvocation(i.ctx, &resultstore.UpdateInvocationRequest{
@@ -359,14 +547,52 @@ func convertToInvocations(results *resultstore.SearchInvocationsResponse) []*Inv
 	return invocations
 }
 
+// InvocationFields represent all invocation fields this client cares about when searching.
+var InvocationFields = [...]string{
+	"invocations.name",
+	"invocations.timing",
+	"invocations.status_attributes",
+	"invocations.invocation_attributes",
+	"invocations.properties",
+}
+
+// SearchIterator streams the invocations that satisfy the query condition within a project, fetching each page as needed.
+func (i Invocations) SearchIterator(ctx context.Context, projectID string, query string, fields ...string) *InvocationIterator {
+	if len(fields) == 0 {
+		fields = InvocationFields[:]
+	}
+	var it InvocationIterator
+	it.fetch = func(token string) (string, error) {
+		req := resultstore.SearchInvocationsRequest{
+			ProjectId: projectID,
+			Query:     query,
+			PageSize:  i.pageSize,
+		}
+		if token != "" {
+			req.PageStart = &resultstore.SearchInvocationsRequest_PageToken{PageToken: token}
+		}
+		results, err := i.down.SearchInvocations(listMask(ctx, fields...), &req)
+		if err != nil {
+			return "", err
+		}
+		it.buf = append(it.buf, convertToInvocations(results)...)
+		return results.NextPageToken, nil
+	}
+	return &it
+}
+
 // Search finds all the invocations that satisfies the query condition within a project.
 func (i Invocations) Search(ctx context.Context, projectID string, query string, fields ...string) ([]*Invocation, error) {
-	results, err := i.down.SearchInvocations(fieldMask(ctx, fields...), &resultstore.SearchInvocationsRequest{
-		ProjectId: projectID,
-		Query:     query,
-	})
-	if err != nil {
-		return nil, err
+	it := i.SearchIterator(ctx, projectID, query, fields...)
+	invocations := []*Invocation{}
+	for {
+		inv, err := it.Next()
+		if err == iterator.Done {
+			return invocations, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		invocations = append(invocations, inv)
 	}
-	return convertToInvocations(results), nil
 }
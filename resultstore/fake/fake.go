@@ -0,0 +1,283 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-process ResultStore gRPC server for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	resultstore "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Server holds ResultStore resources in memory.
+type Server struct {
+	resultstore.UnimplementedResultStoreDownloadServer
+	resultstore.UnimplementedResultStoreUploadServer
+
+	// PageSize limits the results of each list or search page when the request does not.
+	//
+	// Pages are unlimited when zero.
+	PageSize int32
+
+	lock      sync.Mutex
+	resources map[string]proto.Message // by resource name
+	tokens    map[string]string        // authorization token by invocation name
+	finalized map[string]bool          // by invocation name
+}
+
+// NewServer returns an empty server.
+func NewServer() *Server {
+	return &Server{
+		resources: map[string]proto.Message{},
+		tokens:    map[string]string{},
+		finalized: map[string]bool{},
+	}
+}
+
+// AddInvocation stores a copy of the invocation, replacing any with the same name.
+func (s *Server) AddInvocation(inv *resultstore.Invocation) {
+	s.add(inv.Name, inv)
+}
+
+// AddConfiguration stores a copy of the configuration, replacing any with the same name.
+func (s *Server) AddConfiguration(cfg *resultstore.Configuration) {
+	s.add(cfg.Name, cfg)
+}
+
+// AddTarget stores a copy of the target, replacing any with the same name.
+func (s *Server) AddTarget(tgt *resultstore.Target) {
+	s.add(tgt.Name, tgt)
+}
+
+// AddConfiguredTarget stores a copy of the configured target, replacing any with the same name.
+func (s *Server) AddConfiguredTarget(ct *resultstore.ConfiguredTarget) {
+	s.add(ct.Name, ct)
+}
+
+// AddAction stores a copy of the action, replacing any with the same name.
+func (s *Server) AddAction(act *resultstore.Action) {
+	s.add(act.Name, act)
+}
+
+func (s *Server) add(name string, msg proto.Message) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.resources[name] = proto.Clone(msg)
+}
+
+// children returns a copy of each resource under parent, sorted by name.
+//
+// The parent may use - in place of any ID to match every value.
+func (s *Server) children(parent string) []proto.Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var names []string
+	for name := range s.resources {
+		if parent == "" || childOf(parent, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	out := make([]proto.Message, 0, len(names))
+	for _, name := range names {
+		out = append(out, proto.Clone(s.resources[name]))
+	}
+	return out
+}
+
+// Start serves requests on an in-process listener.
+//
+// Returns a connection to the server and a function to stop serving.
+func (s *Server) Start(ctx context.Context) (*grpc.ClientConn, func(), error) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	resultstore.RegisterResultStoreDownloadServer(srv, s)
+	resultstore.RegisterResultStoreUploadServer(srv, s)
+	go srv.Serve(lis)
+	conn, err := grpc.DialContext(
+		ctx,
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		srv.Stop()
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}, nil
+}
+
+// GetInvocation returns the named invocation.
+func (s *Server) GetInvocation(_ context.Context, req *resultstore.GetInvocationRequest) (*resultstore.Invocation, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if inv, ok := s.resources[req.Name].(*resultstore.Invocation); ok {
+		return proto.Clone(inv).(*resultstore.Invocation), nil
+	}
+	return nil, status.Errorf(codes.NotFound, "%s not found", req.Name)
+}
+
+// SearchInvocations returns a page of the invocations in the project, ignoring the query.
+func (s *Server) SearchInvocations(_ context.Context, req *resultstore.SearchInvocationsRequest) (*resultstore.SearchInvocationsResponse, error) {
+	if req.ProjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	var invs []*resultstore.Invocation
+	for _, msg := range s.children("") {
+		inv, ok := msg.(*resultstore.Invocation)
+		if !ok || inv.GetInvocationAttributes().GetProjectId() != req.ProjectId {
+			continue
+		}
+		invs = append(invs, inv)
+	}
+	var resp resultstore.SearchInvocationsResponse
+	first, last, next, err := s.page(len(invs), req.GetPageToken(), req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp.Invocations, resp.NextPageToken = invs[first:last], next
+	return &resp, nil
+}
+
+// ListConfigurations returns a page of the configurations of the parent invocation.
+func (s *Server) ListConfigurations(_ context.Context, req *resultstore.ListConfigurationsRequest) (*resultstore.ListConfigurationsResponse, error) {
+	var cfgs []*resultstore.Configuration
+	for _, msg := range s.children(req.Parent) {
+		if cfg, ok := msg.(*resultstore.Configuration); ok {
+			cfgs = append(cfgs, cfg)
+		}
+	}
+	var resp resultstore.ListConfigurationsResponse
+	first, last, next, err := s.page(len(cfgs), req.GetPageToken(), req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp.Configurations, resp.NextPageToken = cfgs[first:last], next
+	return &resp, nil
+}
+
+// ListTargets returns a page of the targets of the parent invocation.
+func (s *Server) ListTargets(_ context.Context, req *resultstore.ListTargetsRequest) (*resultstore.ListTargetsResponse, error) {
+	var tgts []*resultstore.Target
+	for _, msg := range s.children(req.Parent) {
+		if tgt, ok := msg.(*resultstore.Target); ok {
+			tgts = append(tgts, tgt)
+		}
+	}
+	var resp resultstore.ListTargetsResponse
+	first, last, next, err := s.page(len(tgts), req.GetPageToken(), req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp.Targets, resp.NextPageToken = tgts[first:last], next
+	return &resp, nil
+}
+
+// ListConfiguredTargets returns a page of the configured targets of the parent target.
+//
+// The parent may use - in place of the target ID to match any value.
+func (s *Server) ListConfiguredTargets(_ context.Context, req *resultstore.ListConfiguredTargetsRequest) (*resultstore.ListConfiguredTargetsResponse, error) {
+	var cts []*resultstore.ConfiguredTarget
+	for _, msg := range s.children(req.Parent) {
+		if ct, ok := msg.(*resultstore.ConfiguredTarget); ok {
+			cts = append(cts, ct)
+		}
+	}
+	var resp resultstore.ListConfiguredTargetsResponse
+	first, last, next, err := s.page(len(cts), req.GetPageToken(), req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp.ConfiguredTargets, resp.NextPageToken = cts[first:last], next
+	return &resp, nil
+}
+
+// ListActions returns a page of the actions of the parent configured target.
+//
+// The parent may use - in place of the target and/or configuration ID to match any value.
+func (s *Server) ListActions(_ context.Context, req *resultstore.ListActionsRequest) (*resultstore.ListActionsResponse, error) {
+	var acts []*resultstore.Action
+	for _, msg := range s.children(req.Parent) {
+		if act, ok := msg.(*resultstore.Action); ok {
+			acts = append(acts, act)
+		}
+	}
+	var resp resultstore.ListActionsResponse
+	first, last, next, err := s.page(len(acts), req.GetPageToken(), req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp.Actions, resp.NextPageToken = acts[first:last], next
+	return &resp, nil
+}
+
+// page returns the bounds of the page of n results starting at the token, along with the next token.
+//
+// Tokens are result offsets. The requested size overrides the PageSize of the server.
+func (s *Server) page(n int, token string, size int32) (int, int, string, error) {
+	var first int
+	if token != "" {
+		var err error
+		first, err = strconv.Atoi(token)
+		if err != nil || first < 0 || first > n {
+			return 0, 0, "", status.Errorf(codes.InvalidArgument, "bad page_token %q", token)
+		}
+	}
+	if size <= 0 {
+		size = s.PageSize
+	}
+	last := n
+	if size > 0 && first+int(size) < n {
+		last = first + int(size)
+	}
+	var next string
+	if last < n {
+		next = strconv.Itoa(last)
+	}
+	return first, last, next, nil
+}
+
+// childOf returns true when name is a collection/id immediately under parent.
+func childOf(parent, name string) bool {
+	want := strings.Split(parent, "/")
+	have := strings.Split(name, "/")
+	if len(have) != len(want)+2 {
+		return false
+	}
+	for i, w := range want {
+		if w != "-" && w != have[i] {
+			return false
+		}
+	}
+	return true
+}
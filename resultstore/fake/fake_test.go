@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+
+	"github.com/GoogleCloudPlatform/testgrid/resultstore"
+)
+
+func TestChildOf(t *testing.T) {
+	cases := []struct {
+		parent   string
+		name     string
+		expected bool
+	}{
+		{
+			parent:   "invocations/a",
+			name:     "invocations/a/targets/b",
+			expected: true,
+		},
+		{
+			parent: "invocations/a",
+			name:   "invocations/b/targets/b",
+		},
+		{
+			parent: "invocations/a",
+			name:   "invocations/a/targets/b/configuredTargets/c",
+		},
+		{
+			parent:   "invocations/a/targets/-/configuredTargets/-",
+			name:     "invocations/a/targets/b/configuredTargets/c/actions/d",
+			expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := childOf(tc.parent, tc.name); actual != tc.expected {
+				t.Errorf("childOf(%q, %q) got %t, want %t", tc.parent, tc.name, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := NewServer()
+	srv.AddInvocation(&resultstorepb.Invocation{
+		Name: "invocations/hello",
+		InvocationAttributes: &resultstorepb.InvocationAttributes{
+			ProjectId: "project",
+		},
+	})
+	srv.AddInvocation(&resultstorepb.Invocation{
+		Name: "invocations/other",
+		InvocationAttributes: &resultstorepb.InvocationAttributes{
+			ProjectId: "other",
+		},
+	})
+	srv.AddTarget(&resultstorepb.Target{Name: "invocations/hello/targets/world"})
+	srv.AddAction(&resultstorepb.Action{Name: "invocations/hello/targets/world/configuredTargets/default/actions/test"})
+
+	conn, stop, err := srv.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() got unexpected error: %v", err)
+	}
+	defer stop()
+	client := resultstore.NewClient(conn).WithContext(ctx)
+
+	invs, err := client.Invocations().Search(ctx, "project", "")
+	if err != nil {
+		t.Fatalf("Search() got unexpected error: %v", err)
+	}
+	if len(invs) != 1 || invs[0].Name != "invocations/hello" {
+		t.Errorf("Search() got %v, want invocations/hello", invs)
+	}
+
+	if _, err := client.Invocations().Get("invocations/missing"); err == nil {
+		t.Error("Get() failed to return an error for a missing invocation")
+	}
+
+	targets, err := client.Targets("invocations/hello").List()
+	if err != nil {
+		t.Fatalf("List() targets got unexpected error: %v", err)
+	}
+	if len(targets) != 1 || targets[0].Name != "invocations/hello/targets/world" {
+		t.Errorf("List() targets got %v", targets)
+	}
+
+	actions, err := client.Actions("invocations/hello/targets/-/configuredTargets/-").List()
+	if err != nil {
+		t.Fatalf("List() actions got unexpected error: %v", err)
+	}
+	if len(actions) != 1 || actions[0].Name != "invocations/hello/targets/world/configuredTargets/default/actions/test" {
+		t.Errorf("List() actions got %v", actions)
+	}
+}
+
+func TestPage(t *testing.T) {
+	cases := []struct {
+		name      string
+		n         int
+		token     string
+		size      int32
+		pageSize  int32
+		wantFirst int
+		wantLast  int
+		wantNext  string
+		err       bool
+	}{
+		{
+			name:     "unlimited",
+			n:        3,
+			wantLast: 3,
+		},
+		{
+			name:     "first page",
+			n:        3,
+			size:     2,
+			wantLast: 2,
+			wantNext: "2",
+		},
+		{
+			name:      "last page",
+			n:         3,
+			token:     "2",
+			size:      2,
+			wantFirst: 2,
+			wantLast:  3,
+		},
+		{
+			name:     "server page size",
+			n:        3,
+			pageSize: 1,
+			wantLast: 1,
+			wantNext: "1",
+		},
+		{
+			name:     "request overrides server",
+			n:        3,
+			size:     2,
+			pageSize: 1,
+			wantLast: 2,
+			wantNext: "2",
+		},
+		{
+			name:  "bad token",
+			n:     3,
+			token: "hello",
+			err:   true,
+		},
+		{
+			name:  "token past the end",
+			n:     3,
+			token: "4",
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Server{PageSize: tc.pageSize}
+			first, last, next, err := s.page(tc.n, tc.token, tc.size)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("page() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("page() failed to return an error")
+			case first != tc.wantFirst || last != tc.wantLast || next != tc.wantNext:
+				t.Errorf("page() got %d, %d, %q, want %d, %d, %q", first, last, next, tc.wantFirst, tc.wantLast, tc.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	resultstore "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Finalized returns true after the named invocation is finalized.
+func (s *Server) Finalized(invocation string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.finalized[invocation]
+}
+
+// invocationOf returns the name of the invocation containing the resource.
+func invocationOf(name string) string {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) < 2 {
+		return name
+	}
+	return parts[0] + "/" + parts[1]
+}
+
+// writable returns an error unless the token may change the resource.
+//
+// Requires the lock.
+func (s *Server) writable(name, token string) error {
+	inv := invocationOf(name)
+	want, ok := s.tokens[inv]
+	switch {
+	case !ok:
+		return status.Errorf(codes.NotFound, "%s not found", inv)
+	case want != token:
+		return status.Errorf(codes.PermissionDenied, "wrong authorization_token for %s", inv)
+	case s.finalized[inv]:
+		return status.Errorf(codes.FailedPrecondition, "%s is finalized", inv)
+	}
+	return nil
+}
+
+// create stores a copy of the msg at parent/collection/id, returning the copy.
+//
+// The parent must exist, unless it is empty.
+func (s *Server) create(parent, collection, id, token string, msg proto.Message, setName func(proto.Message, string)) (proto.Message, error) {
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s id is required", collection)
+	}
+	name := collection + "/" + id
+	if parent != "" {
+		name = parent + "/" + name
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if parent != "" {
+		if err := s.writable(parent, token); err != nil {
+			return nil, err
+		}
+		if _, ok := s.resources[parent]; !ok {
+			return nil, status.Errorf(codes.NotFound, "%s not found", parent)
+		}
+	}
+	if _, ok := s.resources[name]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "%s already exists", name)
+	}
+	msg = proto.Clone(msg)
+	setName(msg, name)
+	s.resources[name] = msg
+	if parent == "" {
+		s.tokens[name] = token
+	}
+	return proto.Clone(msg), nil
+}
+
+// CreateInvocation stores the invocation under the requested ID.
+func (s *Server) CreateInvocation(_ context.Context, req *resultstore.CreateInvocationRequest) (*resultstore.Invocation, error) {
+	inv := req.Invocation
+	if inv == nil {
+		inv = &resultstore.Invocation{}
+	}
+	msg, err := s.create("", "invocations", req.InvocationId, req.AuthorizationToken, inv, func(m proto.Message, name string) {
+		m.(*resultstore.Invocation).Name = name
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*resultstore.Invocation), nil
+}
+
+// UpdateInvocation replaces the timing, status_attributes, invocation_attributes and/or properties of an invocation.
+func (s *Server) UpdateInvocation(_ context.Context, req *resultstore.UpdateInvocationRequest) (*resultstore.Invocation, error) {
+	name := req.GetInvocation().GetName()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.writable(name, req.AuthorizationToken); err != nil {
+		return nil, err
+	}
+	inv, ok := s.resources[name].(*resultstore.Invocation)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", name)
+	}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "timing":
+			inv.Timing = req.Invocation.Timing
+		case "status_attributes":
+			inv.StatusAttributes = req.Invocation.StatusAttributes
+		case "invocation_attributes":
+			inv.InvocationAttributes = req.Invocation.InvocationAttributes
+		case "properties":
+			inv.Properties = req.Invocation.Properties
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update %s", path)
+		}
+	}
+	return proto.Clone(inv).(*resultstore.Invocation), nil
+}
+
+// FinalizeInvocation prevents further changes to the invocation and its resources.
+func (s *Server) FinalizeInvocation(_ context.Context, req *resultstore.FinalizeInvocationRequest) (*resultstore.FinalizeInvocationResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.writable(req.Name, req.AuthorizationToken); err != nil {
+		return nil, err
+	}
+	s.finalized[req.Name] = true
+	return &resultstore.FinalizeInvocationResponse{Name: req.Name}, nil
+}
+
+// CreateConfiguration stores the configuration under the parent invocation.
+func (s *Server) CreateConfiguration(_ context.Context, req *resultstore.CreateConfigurationRequest) (*resultstore.Configuration, error) {
+	cfg := req.Configuration
+	if cfg == nil {
+		cfg = &resultstore.Configuration{}
+	}
+	msg, err := s.create(req.Parent, "configs", req.ConfigId, req.AuthorizationToken, cfg, func(m proto.Message, name string) {
+		m.(*resultstore.Configuration).Name = name
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*resultstore.Configuration), nil
+}
+
+// CreateTarget stores the target under the parent invocation.
+func (s *Server) CreateTarget(_ context.Context, req *resultstore.CreateTargetRequest) (*resultstore.Target, error) {
+	tgt := req.Target
+	if tgt == nil {
+		tgt = &resultstore.Target{}
+	}
+	msg, err := s.create(req.Parent, "targets", req.TargetId, req.AuthorizationToken, tgt, func(m proto.Message, name string) {
+		m.(*resultstore.Target).Name = name
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*resultstore.Target), nil
+}
+
+// CreateConfiguredTarget stores the configured target under the parent target.
+func (s *Server) CreateConfiguredTarget(_ context.Context, req *resultstore.CreateConfiguredTargetRequest) (*resultstore.ConfiguredTarget, error) {
+	ct := req.ConfiguredTarget
+	if ct == nil {
+		ct = &resultstore.ConfiguredTarget{}
+	}
+	msg, err := s.create(req.Parent, "configuredTargets", req.ConfigId, req.AuthorizationToken, ct, func(m proto.Message, name string) {
+		m.(*resultstore.ConfiguredTarget).Name = name
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*resultstore.ConfiguredTarget), nil
+}
+
+// CreateAction stores the action under the parent configured target.
+func (s *Server) CreateAction(_ context.Context, req *resultstore.CreateActionRequest) (*resultstore.Action, error) {
+	act := req.Action
+	if act == nil {
+		act = &resultstore.Action{}
+	}
+	msg, err := s.create(req.Parent, "actions", req.ActionId, req.AuthorizationToken, act, func(m proto.Message, name string) {
+		m.(*resultstore.Action).Name = name
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*resultstore.Action), nil
+}
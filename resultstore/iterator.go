@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"google.golang.org/api/iterator"
+)
+
+// pager fetches successive pages of a list or search request.
+type pager struct {
+	// fetch buffers the page at token, returning the token of the next page.
+	fetch func(token string) (string, error)
+	token string
+	done  bool
+	err   error
+}
+
+// nextPage buffers the next page, returning iterator.Done after the last one.
+//
+// Errors are sticky, so later calls return the same error.
+func (p *pager) nextPage() error {
+	if p.err != nil {
+		return p.err
+	}
+	if p.done {
+		return iterator.Done
+	}
+	next, err := p.fetch(p.token)
+	if err != nil {
+		p.err = err
+		return err
+	}
+	p.token = next
+	p.done = next == ""
+	return nil
+}
+
+// InvocationIterator streams the invocations matching a search.
+type InvocationIterator struct {
+	pager
+	buf []*Invocation
+}
+
+// Next returns the next invocation, or iterator.Done after the last one.
+func (it *InvocationIterator) Next() (*Invocation, error) {
+	for len(it.buf) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+	inv := it.buf[0]
+	it.buf = it.buf[1:]
+	return inv, nil
+}
+
+// ConfigurationIterator streams the configurations of an invocation.
+type ConfigurationIterator struct {
+	pager
+	buf []Configuration
+}
+
+// Next returns the next configuration, or iterator.Done after the last one.
+func (it *ConfigurationIterator) Next() (*Configuration, error) {
+	for len(it.buf) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+	cfg := it.buf[0]
+	it.buf = it.buf[1:]
+	return &cfg, nil
+}
+
+// TargetIterator streams the targets of an invocation.
+type TargetIterator struct {
+	pager
+	buf []Target
+}
+
+// Next returns the next target, or iterator.Done after the last one.
+func (it *TargetIterator) Next() (*Target, error) {
+	for len(it.buf) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+	tgt := it.buf[0]
+	it.buf = it.buf[1:]
+	return &tgt, nil
+}
+
+// ConfiguredTargetIterator streams the configured targets of a target.
+type ConfiguredTargetIterator struct {
+	pager
+	buf []ConfiguredTarget
+}
+
+// Next returns the next configured target, or iterator.Done after the last one.
+func (it *ConfiguredTargetIterator) Next() (*ConfiguredTarget, error) {
+	for len(it.buf) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+	ct := it.buf[0]
+	it.buf = it.buf[1:]
+	return &ct, nil
+}
+
+// TestIterator streams the test actions of a configured target.
+type TestIterator struct {
+	pager
+	buf []Test
+}
+
+// Next returns the next test, or iterator.Done after the last one.
+func (it *TestIterator) Next() (*Test, error) {
+	for len(it.buf) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+	test := it.buf[0]
+	it.buf = it.buf[1:]
+	return &test, nil
+}
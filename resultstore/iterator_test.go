@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/api/iterator"
+)
+
+func TestTargetIterator(t *testing.T) {
+	boom := fmt.Errorf("boom")
+	cases := []struct {
+		name     string
+		pages    map[string][]string // token: names
+		next     map[string]string   // token: next token
+		fail     string              // token that fails
+		expected []string
+		err      error
+	}{
+		{
+			name:     "empty",
+			pages:    map[string][]string{},
+			expected: nil,
+			err:      iterator.Done,
+		},
+		{
+			name: "multiple pages",
+			pages: map[string][]string{
+				"":  {"a", "b"},
+				"1": {"c"},
+			},
+			next: map[string]string{
+				"": "1",
+			},
+			expected: []string{"a", "b", "c"},
+			err:      iterator.Done,
+		},
+		{
+			name: "skip empty pages",
+			pages: map[string][]string{
+				"":  {},
+				"1": {"a"},
+			},
+			next: map[string]string{
+				"": "1",
+			},
+			expected: []string{"a"},
+			err:      iterator.Done,
+		},
+		{
+			name: "sticky error",
+			pages: map[string][]string{
+				"": {"a"},
+			},
+			next: map[string]string{
+				"": "1",
+			},
+			fail:     "1",
+			expected: []string{"a"},
+			err:      boom,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var it TargetIterator
+			var fetches int
+			it.fetch = func(token string) (string, error) {
+				fetches++
+				if tc.fail != "" && token == tc.fail {
+					return "", boom
+				}
+				for _, name := range tc.pages[token] {
+					it.buf = append(it.buf, Target{Name: name})
+				}
+				return tc.next[token], nil
+			}
+			var actual []string
+			var err error
+			for {
+				var tgt *Target
+				tgt, err = it.Next()
+				if err != nil {
+					break
+				}
+				actual = append(actual, tgt.Name)
+			}
+			if err != tc.err {
+				t.Errorf("Next() got error %v, want %v", err, tc.err)
+			}
+			if !deepEqual(actual, tc.expected) {
+				t.Errorf(diff(actual, tc.expected))
+			}
+			before := fetches
+			if _, again := it.Next(); again != tc.err {
+				t.Errorf("Next() after %v got %v", tc.err, again)
+			}
+			if fetches != before {
+				t.Errorf("Next() fetched again after %v", tc.err)
+			}
+		})
+	}
+}
@@ -260,11 +260,13 @@ func Properties(pairs ...string) []Property {
 // Property represents a key-value pairing.
 type Property = resultstore.Property
 
+// properties converts the list into protos.
+//
+// Copies each key and value, as the proto embeds a mutex that must not be copied.
 func properties(ps []Property) []*Property {
 	var out []*Property
-	for _, p := range ps {
-		p2 := p
-		out = append(out, &p2)
+	for i := range ps {
+		out = append(out, &Property{Key: ps[i].Key, Value: ps[i].Value})
 	}
 	return out
 }
@@ -272,7 +274,7 @@ func properties(ps []Property) []*Property {
 func fromProperties(ps []*Property) []Property {
 	var out []Property
 	for _, p := range ps {
-		out = append(out, *p)
+		out = append(out, Property{Key: p.Key, Value: p.Value})
 	}
 	return out
 }
@@ -449,6 +451,9 @@ const (
 
 // Test represents a test action, containing action, suite and warnings.
 type Test struct {
+	// Name of the action, immutable.
+	Name string
+
 	// Action holds generic metadata about the test
 	Action
 	// Suite holds a variety of case and sub-suite data.
@@ -460,6 +465,7 @@ type Test struct {
 // To converts the test into the corresponding ResultStore Action proto
 func (t Test) To() *resultstore.Action {
 	a := t.Action.to()
+	a.Name = t.Name
 	a.ActionType = &resultstore.Action_TestAction{
 		TestAction: &resultstore.TestAction{
 			Warnings:  warnings(t.Warnings),
@@ -480,6 +486,7 @@ func fromTestAction(ta *resultstore.TestAction) (Suite, []string) {
 
 func fromTest(a *resultstore.Action) Test {
 	t := Test{
+		Name:   a.Name,
 		Action: fromAction(a),
 	}
 	t.Suite, t.Warnings = fromTestAction(a.GetTestAction())
@@ -704,3 +711,74 @@ func (t Target) To() *resultstore.Target {
 	}
 	return &tgt
 }
+
+// Configuration represents the settings used to build and test targets, such as the default.
+type Configuration struct {
+	// Name of the configuration, immutable.
+	Name string
+
+	// Status specifying whether the configuration completed successfully.
+	Status Status
+	// Description of the status
+	Description string
+
+	// Properties of the configuration
+	Properties []Property
+}
+
+func fromConfiguration(c *resultstore.Configuration) Configuration {
+	cfg := Configuration{
+		Name:       c.Name,
+		Properties: fromProperties(c.Properties),
+	}
+	cfg.Status, cfg.Description = fromStatus(c.StatusAttributes)
+	return cfg
+}
+
+// To converts a configuration into the corresponding ResultStore Configuration proto.
+func (c Configuration) To() *resultstore.Configuration {
+	return &resultstore.Configuration{
+		Name:             c.Name,
+		StatusAttributes: status(c.Status, c.Description),
+		Properties:       properties(c.Properties),
+	}
+}
+
+// ConfiguredTarget represents a target built and tested with a configuration.
+type ConfiguredTarget struct {
+	// Name of the configured target, immutable.
+	Name string
+
+	// Start time of the configured target.
+	Start time.Time
+	// Duration the configured target ran.
+	Duration time.Duration
+
+	// Status specifying whether the configured target completed successfully.
+	Status Status
+	// Description of the status
+	Description string
+
+	// Properties of the configured target
+	Properties []Property
+}
+
+func fromConfiguredTarget(ct *resultstore.ConfiguredTarget) ConfiguredTarget {
+	ret := ConfiguredTarget{
+		Name:       ct.Name,
+		Properties: fromProperties(ct.Properties),
+	}
+	ret.Start, ret.Duration = fromTiming(ct.Timing)
+	ret.Status, ret.Description = fromStatus(ct.StatusAttributes)
+	return ret
+}
+
+// To converts a configured target into the corresponding ResultStore ConfiguredTarget proto.
+func (ct ConfiguredTarget) To() *resultstore.ConfiguredTarget {
+	return &resultstore.ConfiguredTarget{
+		Name:             ct.Name,
+		Timing:           timing(ct.Start, ct.Duration),
+		StatusAttributes: status(ct.Status, ct.Description),
+		Properties:       properties(ct.Properties),
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// InvocationTree holds an invocation along with all of its resources.
+type InvocationTree struct {
+	Invocation     Invocation
+	Configurations []Configuration
+	Targets        []TargetTree
+}
+
+// TargetTree holds a target along with its configured targets.
+type TargetTree struct {
+	Target
+	ConfiguredTargets []ConfiguredTargetTree
+}
+
+// ConfiguredTargetTree holds a configured target along with its test actions.
+type ConfiguredTargetTree struct {
+	ConfiguredTarget
+	Tests []Test
+}
+
+// invocationGetFields are the fields of an invocation get request.
+var invocationGetFields = []string{
+	"name",
+	"timing",
+	"status_attributes",
+	"invocation_attributes",
+	"properties",
+}
+
+// Tree fetches the named invocation along with every page of its configurations,
+// targets, configured targets and test actions.
+//
+// Lists the resources of up to concurrency targets at a time.
+func (c Client) Tree(name string, concurrency int) (*InvocationTree, error) {
+	if concurrency < 1 {
+		return nil, fmt.Errorf("concurrency must be positive, not %d", concurrency)
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	c.WithContext(ctx)
+
+	inv, err := c.Invocations().Get(name, invocationGetFields...)
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	tree := InvocationTree{Invocation: *inv}
+	if tree.Configurations, err = c.Configurations(name).List(); err != nil {
+		return nil, fmt.Errorf("list configurations: %w", err)
+	}
+	targets, err := c.Targets(name).List()
+	if err != nil {
+		return nil, fmt.Errorf("list targets: %w", err)
+	}
+
+	tree.Targets = make([]TargetTree, len(targets))
+	indices := make(chan int)
+	errs := make(chan error, concurrency)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				tt, err := c.targetTree(targets[idx])
+				if err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+				tree.Targets[idx] = *tt
+			}
+		}()
+	}
+	func() {
+		defer close(indices)
+		for i := range targets {
+			select {
+			case <-ctx.Done():
+				return
+			case indices <- i:
+			}
+		}
+	}()
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// targetTree lists the configured targets of the target and their test actions.
+func (c Client) targetTree(target Target) (*TargetTree, error) {
+	cts, err := c.ConfiguredTargets(target.Name, "").List()
+	if err != nil {
+		return nil, fmt.Errorf("list %s configured targets: %w", target.Name, err)
+	}
+	tt := TargetTree{
+		Target:            target,
+		ConfiguredTargets: make([]ConfiguredTargetTree, 0, len(cts)),
+	}
+	for _, ct := range cts {
+		tests, err := c.Actions(ct.Name).List()
+		if err != nil {
+			return nil, fmt.Errorf("list %s actions: %w", ct.Name, err)
+		}
+		tt.ConfiguredTargets = append(tt.ConfiguredTargets, ConfiguredTargetTree{
+			ConfiguredTarget: ct,
+			Tests:            tests,
+		})
+	}
+	return &tt, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"context"
+	"testing"
+
+	resultstore "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+
+	"github.com/GoogleCloudPlatform/testgrid/resultstore/fake"
+)
+
+func TestTree(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := fake.NewServer()
+	srv.PageSize = 1 // force paging
+
+	const inv = "invocations/hello"
+	srv.AddInvocation(Invocation{Name: inv, Project: "project", Status: resultstore.Status_PASSED}.To())
+	srv.AddConfiguration(Configuration{Name: inv + "/configs/default"}.To())
+	srv.AddConfiguration(Configuration{Name: inv + "/configs/race"}.To())
+	for _, target := range []string{"a", "b", "c"} {
+		tgt := Target{Status: resultstore.Status_PASSED}.To()
+		tgt.Name = inv + "/targets/" + target
+		srv.AddTarget(tgt)
+		for _, config := range []string{"default", "race"} {
+			ct := tgt.Name + "/configuredTargets/" + config
+			srv.AddConfiguredTarget(ConfiguredTarget{Name: ct}.To())
+			srv.AddAction(Test{Name: ct + "/actions/1"}.To())
+			srv.AddAction(Test{Name: ct + "/actions/2"}.To())
+		}
+	}
+	srv.AddTarget(&resultstore.Target{Name: "invocations/other/targets/a"})
+
+	conn, stop, err := srv.Start(ctx)
+	if err != nil {
+		t.Fatalf("Start() got unexpected error: %v", err)
+	}
+	defer stop()
+	client := NewClient(conn).WithContext(ctx)
+
+	if _, err := client.Tree(inv, 0); err == nil {
+		t.Error("Tree() failed to return an error for zero concurrency")
+	}
+	if _, err := client.Tree("invocations/missing", 2); err == nil {
+		t.Error("Tree() failed to return an error for a missing invocation")
+	}
+
+	tree, err := client.Tree(inv, 2)
+	if err != nil {
+		t.Fatalf("Tree() got unexpected error: %v", err)
+	}
+	if tree.Invocation.Name != inv || tree.Invocation.Status != resultstore.Status_PASSED {
+		t.Errorf("Tree() got invocation %v", tree.Invocation)
+	}
+	if n := len(tree.Configurations); n != 2 {
+		t.Errorf("Tree() got %d configurations, want 2", n)
+	}
+	if n := len(tree.Targets); n != 3 {
+		t.Fatalf("Tree() got %d targets, want 3", n)
+	}
+	for i, tt := range tree.Targets {
+		if want := inv + "/targets/" + string(rune('a'+i)); tt.Name != want {
+			t.Errorf("Tree() got target %q, want %q", tt.Name, want)
+		}
+		if n := len(tt.ConfiguredTargets); n != 2 {
+			t.Errorf("Tree() got %d %s configured targets, want 2", n, tt.Name)
+		}
+		for _, ct := range tt.ConfiguredTargets {
+			if n := len(ct.Tests); n != 2 {
+				t.Errorf("Tree() got %d %s tests, want 2", n, ct.Name)
+			}
+		}
+	}
+
+	invs, err := client.Invocations().Search(ctx, "project", "")
+	if err != nil {
+		t.Fatalf("Search() got unexpected error: %v", err)
+	}
+	if len(invs) != 1 {
+		t.Errorf("Search() got %d invocations, want 1", len(invs))
+	}
+}
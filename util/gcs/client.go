@@ -18,7 +18,9 @@ package gcs
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"cloud.google.com/go/storage"
@@ -50,11 +52,36 @@ type Opener interface {
 	Open(ctx context.Context, path Path) (io.ReadCloser, error)
 }
 
+// A Stater returns the attributes of an object.
+type Stater interface {
+	Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error)
+}
+
 type Client interface {
 	Uploader
 	Downloader
 }
 
+// ReaderAttrs returns the attributes of the object an Opener opened,
+// or nil when the reader does not describe its object.
+//
+// Unlike a separate Stat, these always describe the content being read.
+func ReaderAttrs(r io.Reader) *storage.ReaderObjectAttrs {
+	switch rr := r.(type) {
+	case *storage.Reader:
+		return &rr.Attrs
+	case *attrsReader:
+		return &rr.attrs
+	}
+	return nil
+}
+
+// attrsReader reads an object along with its attributes.
+type attrsReader struct {
+	io.ReadCloser
+	attrs storage.ReaderObjectAttrs
+}
+
 // NewClient returns a GCSUploadClient for the storage.Client.
 func NewClient(client *storage.Client) Client {
 	return realGCSClient{client}
@@ -81,6 +108,136 @@ func (rgc realGCSClient) Objects(ctx context.Context, path Path, delimiter, star
 	})
 }
 
+func (rgc realGCSClient) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	return rgc.client.Bucket(path.Bucket()).Object(path.Object()).Attrs(ctx)
+}
+
 func (rgc realGCSClient) Upload(ctx context.Context, path Path, buf []byte, worldReadable bool, cacheControl string) error {
 	return Upload(ctx, rgc.client, path, buf, worldReadable, cacheControl)
 }
+
+// NewSchemeClient returns a Client that sends requests to the client for the path's scheme.
+//
+// For example {"gs": gcsClient, "s3": s3Client} reads gs:// paths from GCS and s3:// paths from S3.
+func NewSchemeClient(clients map[string]Client) Client {
+	return schemeClient(clients)
+}
+
+type schemeClient map[string]Client
+
+func (sc schemeClient) client(path Path) (Client, error) {
+	c, ok := sc[path.url.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("no client for %s", path)
+	}
+	return c, nil
+}
+
+func (sc schemeClient) Open(ctx context.Context, path Path) (io.ReadCloser, error) {
+	c, err := sc.client(path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Open(ctx, path)
+}
+
+func (sc schemeClient) Objects(ctx context.Context, path Path, delimiter, startOffset string) Iterator {
+	c, err := sc.client(path)
+	if err != nil {
+		return errIterator{err}
+	}
+	return c.Objects(ctx, path, delimiter, startOffset)
+}
+
+func (sc schemeClient) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	c, err := sc.client(path)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := c.(Stater)
+	if !ok {
+		return nil, fmt.Errorf("%s client cannot stat %s", path.url.Scheme, path)
+	}
+	return st.Stat(ctx, path)
+}
+
+func (sc schemeClient) Upload(ctx context.Context, path Path, buf []byte, worldReadable bool, cacheControl string) error {
+	c, err := sc.client(path)
+	if err != nil {
+		return err
+	}
+	return c.Upload(ctx, path, buf, worldReadable, cacheControl)
+}
+
+// ClientOptions configures the schemes of NewDefaultClient.
+type ClientOptions struct {
+	// Creds is the path to .json credentials for gs:// paths, using the default credentials when empty.
+	Creds string
+	// RequireGCS fails when the storage client cannot be created,
+	// rather than returning the error from every gs:// request.
+	RequireGCS bool
+	// S3Endpoint serves s3:// paths when set, signing requests with
+	// $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY when set.
+	S3Endpoint string
+	// S3Region signs S3 requests, defaults to us-east-1.
+	S3Region string
+}
+
+// NewDefaultClient returns a scheme client for file://, gs:// and, when configured, s3:// paths,
+// along with a function that releases it.
+func NewDefaultClient(ctx context.Context, opt ClientOptions) (Client, func(), error) {
+	clients := map[string]Client{
+		"file": NewLocalClient(),
+	}
+	release := func() {}
+	storageClient, err := ClientWithCreds(ctx, opt.Creds)
+	switch {
+	case err == nil:
+		clients["gs"] = NewClient(storageClient)
+		release = func() { storageClient.Close() }
+	case opt.RequireGCS:
+		return nil, nil, fmt.Errorf("create storage client: %w", err)
+	default:
+		clients["gs"] = errClient{fmt.Errorf("create storage client: %w", err)}
+	}
+	if opt.S3Endpoint != "" {
+		s3Client, err := NewS3Client(S3Options{
+			Endpoint:  opt.S3Endpoint,
+			Region:    opt.S3Region,
+			AccessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		})
+		if err != nil {
+			release()
+			return nil, nil, fmt.Errorf("create S3 client: %w", err)
+		}
+		clients["s3"] = s3Client
+	}
+	return NewSchemeClient(clients), release, nil
+}
+
+// errClient returns an error from every request.
+type errClient struct {
+	err error
+}
+
+func (ec errClient) Open(context.Context, Path) (io.ReadCloser, error) {
+	return nil, ec.err
+}
+
+func (ec errClient) Objects(context.Context, Path, string, string) Iterator {
+	return errIterator{ec.err}
+}
+
+func (ec errClient) Upload(context.Context, Path, []byte, bool, string) error {
+	return ec.err
+}
+
+// errIterator returns an error from every call to Next.
+type errIterator struct {
+	err error
+}
+
+func (ei errIterator) Next() (*storage.ObjectAttrs, error) {
+	return nil, ei.err
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+)
+
+func TestSchemeClient(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"hello.txt": "local",
+	}, nil)
+	defer cleanup()
+	_, s3, s3Cleanup := newFakeS3(t, map[string]fakeS3Object{
+		"bucket/hello.txt": {data: "s3"},
+	})
+	defer s3Cleanup()
+	client := NewSchemeClient(map[string]Client{
+		"file": NewLocalClient(),
+		"s3":   s3,
+	})
+
+	cases := []struct {
+		name     string
+		path     Path
+		expected string
+		err      bool
+	}{
+		{
+			name:     "read local files",
+			path:     resolveOrDie(root, "hello.txt"),
+			expected: "local",
+		},
+		{
+			name:     "read s3 objects",
+			path:     newPathOrDie("s3://bucket/hello.txt"),
+			expected: "s3",
+		},
+		{
+			name: "reject unknown schemes",
+			path: newPathOrDie("gs://bucket/hello.txt"),
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, err := client.Open(ctx, tc.path)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("Open() got unexpected error: %v", err)
+				}
+				if _, err := client.Objects(ctx, tc.path, "", "").Next(); err == nil {
+					t.Error("Objects() failed to return an error")
+				}
+				return
+			case tc.err:
+				t.Fatal("Open() failed to return an error")
+			}
+			defer r.Close()
+			buf, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() got unexpected error: %v", err)
+			}
+			if actual := string(buf); actual != tc.expected {
+				t.Errorf("Open() got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewDefaultClient(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"hello.txt": "local",
+	}, nil)
+	defer cleanup()
+	badCreds := resolveOrDie(root, "missing-creds.json").url.Path
+
+	cases := []struct {
+		name    string
+		opt     ClientOptions
+		path    Path
+		err     bool
+		openErr bool
+	}{
+		{
+			name: "read local files without gcs",
+			opt:  ClientOptions{Creds: badCreds},
+			path: resolveOrDie(root, "hello.txt"),
+		},
+		{
+			name:    "fail gcs reads without gcs",
+			opt:     ClientOptions{Creds: badCreds},
+			path:    newPathOrDie("gs://bucket/hello.txt"),
+			openErr: true,
+		},
+		{
+			name: "require gcs",
+			opt:  ClientOptions{Creds: badCreds, RequireGCS: true},
+			err:  true,
+		},
+		{
+			name:    "reject s3 paths without an endpoint",
+			opt:     ClientOptions{Creds: badCreds},
+			path:    newPathOrDie("s3://bucket/hello.txt"),
+			openErr: true,
+		},
+		{
+			name: "reject bad s3 endpoints",
+			opt:  ClientOptions{Creds: badCreds, S3Endpoint: "ftp://example.com"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client, release, err := NewDefaultClient(ctx, tc.opt)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("NewDefaultClient() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("NewDefaultClient() failed to return an error")
+			}
+			defer release()
+			r, err := client.Open(ctx, tc.path)
+			switch {
+			case err != nil:
+				if !tc.openErr {
+					t.Errorf("Open() got unexpected error: %v", err)
+				}
+				return
+			case tc.openErr:
+				t.Fatal("Open() failed to return an error")
+			}
+			r.Close()
+		})
+	}
+}
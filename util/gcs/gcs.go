@@ -43,6 +43,9 @@ func ClientWithCreds(ctx context.Context, creds ...string) (*storage.Client, err
 }
 
 // Path parses gs://bucket/obj urls
+//
+// Also accepts file:///path/to/obj urls, which NewLocalClient reads and writes,
+// and s3://bucket/obj urls, which NewS3Client reads and writes.
 type Path struct {
 	url url.URL
 }
@@ -70,13 +73,15 @@ func (g *Path) Set(v string) error {
 	return g.SetURL(u)
 }
 
-// SetURL updates value to the passed in gs://bucket/obj url
+// SetURL updates value to the passed in gs://bucket/obj, s3://bucket/obj or file:///path/to/obj url
 func (g *Path) SetURL(u *url.URL) error {
 	switch {
 	case u == nil:
 		return errors.New("nil url")
-	case u.Scheme != "gs":
-		return fmt.Errorf("must use a gs:// url: %s", u)
+	case u.Scheme != "gs" && u.Scheme != "s3" && u.Scheme != "file":
+		return fmt.Errorf("must use a gs://, s3:// or file:// url: %s", u)
+	case u.Scheme == "file" && u.Host != "":
+		return fmt.Errorf("file:// url may not contain a host: %s", u)
 	case strings.Contains(u.Host, ":"):
 		return fmt.Errorf("gs://bucket may not contain a port: %s", u)
 	case u.Opaque != "":
@@ -102,6 +107,8 @@ func (g Path) ResolveReference(ref *url.URL) (*Path, error) {
 }
 
 // Bucket returns bucket in gs://bucket/obj
+//
+// File paths have an empty bucket.
 func (g Path) Bucket() string {
 	return g.url.Host
 }
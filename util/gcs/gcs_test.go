@@ -40,6 +40,22 @@ func Test_SetURL(t *testing.T) {
 			bucket: "first",
 			object: "second",
 		},
+		{
+			name:   "s3 bucket and object",
+			url:    "s3://first/second",
+			bucket: "first",
+			object: "second",
+		},
+		{
+			name:   "local file",
+			url:    "file:///path/to/obj",
+			object: "path/to/obj",
+		},
+		{
+			name: "reject file hosts",
+			url:  "file://host/path/to/obj",
+			err:  true,
+		},
 		{
 			name: "reject files",
 			url:  "/path/to/my/bucket",
@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// NewLocalClient returns a Client that reads and writes file:// paths.
+//
+// Objects are files, so file:///tmp/logs/job/123/started.json is the object
+// tmp/logs/job/123/started.json in the empty bucket.
+// Symlinks to directories are listed with an x-goog-meta-link to their target,
+// emulating the links bootstrap.py creates for PR jobs.
+func NewLocalClient() Client {
+	return localClient{}
+}
+
+type localClient struct{}
+
+// localPath returns the filesystem location of a file:// path.
+func localPath(path Path) (string, error) {
+	if path.url.Scheme != "file" {
+		return "", fmt.Errorf("not a file:// path: %s", path)
+	}
+	return filepath.FromSlash(path.url.Path), nil
+}
+
+// objectName converts a filesystem location into an object name.
+func objectName(loc string) string {
+	return strings.TrimPrefix(filepath.ToSlash(loc), "/")
+}
+
+func (lc localClient) Open(ctx context.Context, path Path) (io.ReadCloser, error) {
+	loc, err := localPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(loc); os.IsNotExist(err) || (err == nil && info.IsDir()) {
+		return nil, storage.ErrObjectNotExist
+	}
+	f, err := os.Open(loc)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	attrs := fileAttrs(objectName(loc), info)
+	return &attrsReader{
+		ReadCloser: f,
+		attrs: storage.ReaderObjectAttrs{
+			Size:         attrs.Size,
+			LastModified: attrs.Updated,
+			Generation:   attrs.Generation,
+		},
+	}, nil
+}
+
+func (lc localClient) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	loc, err := localPath(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(loc)
+	if os.IsNotExist(err) || (err == nil && info.IsDir()) {
+		return nil, storage.ErrObjectNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fileAttrs(objectName(loc), info), nil
+}
+
+// fileAttrs returns the attributes of a file, using its modification time as the generation.
+func fileAttrs(name string, info os.FileInfo) *storage.ObjectAttrs {
+	return &storage.ObjectAttrs{
+		Name:       name,
+		Size:       info.Size(),
+		Updated:    info.ModTime(),
+		Generation: info.ModTime().UnixNano(),
+	}
+}
+
+// Objects lists the files under path, emulating GCS delimiter and start offset semantics.
+//
+// Objects at or after the start offset are listed in lexicographic order.
+// When the delimiter is set, objects with the delimiter after the prefix
+// are collapsed into a single prefix entry, without walking the directories
+// that collapse.
+func (lc localClient) Objects(ctx context.Context, path Path, delimiter, startOffset string) Iterator {
+	loc, err := localPath(path)
+	if err != nil {
+		return &localIterator{ctx: ctx, err: err}
+	}
+	prefix := objectName(loc)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	root, err := filepath.EvalSymlinks(loc)
+	if os.IsNotExist(err) {
+		return &localIterator{ctx: ctx}
+	}
+	if err != nil {
+		return &localIterator{ctx: ctx, err: err}
+	}
+	var objects []*storage.ObjectAttrs
+	prefixes := map[string]bool{}
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if rel == "." || delimiter == "" {
+				return nil
+			}
+			idx := strings.Index(rel+"/", delimiter)
+			if idx < 0 {
+				return nil
+			}
+			// Everything under the directory collapses into the same prefix.
+			collapsed := prefix + rel[:idx] + delimiter
+			if collapsed >= startOffset || strings.HasPrefix(startOffset, collapsed) {
+				empty, err := emptyDir(p)
+				if err != nil {
+					return err
+				}
+				if !empty {
+					prefixes[collapsed] = true
+				}
+			}
+			return filepath.SkipDir
+		}
+		name := prefix + rel
+		if name < startOffset {
+			return nil
+		}
+		if delimiter != "" {
+			if idx := strings.Index(rel, delimiter); idx >= 0 {
+				prefixes[prefix+rel[:idx+len(delimiter)]] = true
+				return nil
+			}
+		}
+		attrs := fileAttrs(name, info)
+		if info.Mode()&os.ModeSymlink != 0 {
+			attrs.Metadata, err = linkMetadata(p)
+			if err != nil {
+				return fmt.Errorf("link %s: %w", p, err)
+			}
+		}
+		objects = append(objects, attrs)
+		return nil
+	})
+	if err != nil {
+		return &localIterator{ctx: ctx, err: err}
+	}
+	for p := range prefixes {
+		objects = append(objects, &storage.ObjectAttrs{Prefix: p})
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name+objects[i].Prefix < objects[j].Name+objects[j].Prefix
+	})
+	return &localIterator{ctx: ctx, objects: objects}
+}
+
+// emptyDir returns true if the directory has no entries.
+func emptyDir(loc string) (bool, error) {
+	f, err := os.Open(loc)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	_, err = f.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
+
+// linkMetadata returns an x-goog-meta-link to the target of a symlinked directory.
+//
+// Returns nil for symlinks to anything else.
+func linkMetadata(loc string) (map[string]string, error) {
+	target, err := filepath.EvalSymlinks(loc)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, nil
+	}
+	target, err = filepath.Abs(target)
+	if err != nil {
+		return nil, err
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(target)}
+	return map[string]string{"x-goog-meta-link": u.String()}, nil
+}
+
+type localIterator struct {
+	ctx     context.Context
+	objects []*storage.ObjectAttrs
+	err     error
+}
+
+func (li *localIterator) Next() (*storage.ObjectAttrs, error) {
+	if li.err != nil {
+		return nil, li.err
+	}
+	if err := li.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(li.objects) == 0 {
+		return nil, iterator.Done
+	}
+	attrs := li.objects[0]
+	li.objects = li.objects[1:]
+	return attrs, nil
+}
+
+// Upload writes the bytes to the file, creating any missing directories.
+//
+// Files are written atomically; the ACL and cache control are ignored.
+func (lc localClient) Upload(ctx context.Context, path Path, buf []byte, _ bool, _ string) error {
+	loc, err := localPath(path)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(loc)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create %s: %w", dir, err)
+	}
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(loc)+".*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(buf); err != nil {
+		f.Close()
+		return fmt.Errorf("write %s: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close %s: %w", f.Name(), err)
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return fmt.Errorf("chmod %s: %w", f.Name(), err)
+	}
+	if err := os.Rename(f.Name(), loc); err != nil {
+		return fmt.Errorf("rename %s: %w", f.Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iterator"
+)
+
+// localDir creates a temp dir with the specified files, returning its file:// path.
+func localDir(t *testing.T, files map[string]string, links map[string]string) (Path, func()) {
+	dir, err := ioutil.TempDir("", "local")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	for name, content := range files {
+		loc := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(loc), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(loc), err)
+		}
+		if err := ioutil.WriteFile(loc, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", loc, err)
+		}
+	}
+	for name, target := range links {
+		loc := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(loc), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(loc), err)
+		}
+		if err := os.Symlink(target, loc); err != nil {
+			t.Fatalf("Failed to link %s: %v", loc, err)
+		}
+	}
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatalf("Failed to resolve %s: %v", dir, err)
+	}
+	return newPathOrDie("file://" + filepath.ToSlash(dir) + "/"), func() { os.RemoveAll(dir) }
+}
+
+func TestLocalOpen(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"hello/world.txt": "hi",
+	}, nil)
+	defer cleanup()
+	cases := []struct {
+		name     string
+		path     string
+		expected string
+		err      error
+	}{
+		{
+			name:     "basically works",
+			path:     "hello/world.txt",
+			expected: "hi",
+		},
+		{
+			name: "missing files do not exist",
+			path: "hello/missing.txt",
+			err:  storage.ErrObjectNotExist,
+		},
+		{
+			name: "directories do not exist",
+			path: "hello",
+			err:  storage.ErrObjectNotExist,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewLocalClient()
+			path := resolveOrDie(root, tc.path)
+			r, err := client.Open(context.Background(), path)
+			switch {
+			case err != nil:
+				if tc.err == nil || !errors.Is(err, tc.err) {
+					t.Errorf("Open() got unexpected error: %v", err)
+				}
+				return
+			case tc.err != nil:
+				t.Fatalf("Open() failed to return an error")
+			}
+			defer r.Close()
+			buf, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() got unexpected error: %v", err)
+			}
+			if actual := string(buf); actual != tc.expected {
+				t.Errorf("Open() got %q, want %q", actual, tc.expected)
+			}
+			if ra := ReaderAttrs(r); ra == nil || ra.Size != int64(len(tc.expected)) || ra.Generation == 0 {
+				t.Errorf("Open() got unexpected reader attrs: %#v", ra)
+			}
+			attrs, err := localClient{}.Stat(context.Background(), path)
+			if err != nil {
+				t.Fatalf("Stat() got unexpected error: %v", err)
+			}
+			if attrs.Name != path.Object() || attrs.Size != int64(len(tc.expected)) || attrs.Generation == 0 {
+				t.Errorf("Stat() got unexpected attrs: %#v", attrs)
+			}
+		})
+	}
+}
+
+func TestLocalUpload(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"exists.txt": "old",
+	}, nil)
+	defer cleanup()
+	cases := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "basically works",
+			path: "hello.txt",
+		},
+		{
+			name: "create directories",
+			path: "path/to/hello.txt",
+		},
+		{
+			name: "replace existing files",
+			path: "exists.txt",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewLocalClient()
+			path := resolveOrDie(root, tc.path)
+			ctx := context.Background()
+			if err := client.Upload(ctx, path, []byte("new"), DefaultAcl, "no-cache"); err != nil {
+				t.Fatalf("Upload() got unexpected error: %v", err)
+			}
+			r, err := client.Open(ctx, path)
+			if err != nil {
+				t.Fatalf("Open() got unexpected error: %v", err)
+			}
+			defer r.Close()
+			buf, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() got unexpected error: %v", err)
+			}
+			if actual := string(buf); actual != "new" {
+				t.Errorf("Upload() wrote %q, want %q", actual, "new")
+			}
+		})
+	}
+}
+
+func TestLocalObjects(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"job/1/started.json":            "{}",
+		"job/1/finished.json":           "{}",
+		"job/1/artifacts/junit_01.xml":  "<testsuite/>",
+		"job/2/started.json":            "{}",
+		"job/10/started.json":           "{}",
+		"job/latest-build.txt":          "10",
+		"pr-logs/pull/123/job/3/a.json": "{}",
+	}, map[string]string{
+		"job/3": "../pr-logs/pull/123/job/3",
+	})
+	defer cleanup()
+	obj := func(name string) string {
+		return resolveOrDie(root, name).Object()
+	}
+	if err := os.MkdirAll(filepath.FromSlash("/"+obj("job/empty")), 0755); err != nil {
+		t.Fatalf("Failed to create empty dir: %v", err)
+	}
+	cases := []struct {
+		name      string
+		path      string
+		delimiter string
+		offset    string
+		expected  []storage.ObjectAttrs
+	}{
+		{
+			name: "missing directories are empty",
+			path: "missing",
+		},
+		{
+			name:      "delimiter groups directories",
+			path:      "job",
+			delimiter: "/",
+			expected: []storage.ObjectAttrs{
+				{Prefix: obj("job/1/")},
+				{Prefix: obj("job/10/")},
+				{Prefix: obj("job/2/")},
+				{
+					Name: obj("job/3"),
+					Metadata: map[string]string{
+						"x-goog-meta-link": resolveOrDie(root, "pr-logs/pull/123/job/3").String(),
+					},
+				},
+				{Name: obj("job/latest-build.txt")},
+			},
+		},
+		{
+			name:      "start offset skips earlier objects",
+			path:      "job",
+			delimiter: "/",
+			offset:    obj("job/2/"),
+			expected: []storage.ObjectAttrs{
+				{Prefix: obj("job/2/")},
+				{
+					Name: obj("job/3"),
+					Metadata: map[string]string{
+						"x-goog-meta-link": resolveOrDie(root, "pr-logs/pull/123/job/3").String(),
+					},
+				},
+				{Name: obj("job/latest-build.txt")},
+			},
+		},
+		{
+			name:      "start offset inside a prefix includes it",
+			path:      "job",
+			delimiter: "/",
+			offset:    obj("job/1/finished.json"),
+			expected: []storage.ObjectAttrs{
+				{Prefix: obj("job/1/")},
+				{Prefix: obj("job/10/")},
+				{Prefix: obj("job/2/")},
+				{
+					Name: obj("job/3"),
+					Metadata: map[string]string{
+						"x-goog-meta-link": resolveOrDie(root, "pr-logs/pull/123/job/3").String(),
+					},
+				},
+				{Name: obj("job/latest-build.txt")},
+			},
+		},
+		{
+			name: "no delimiter lists everything",
+			path: "job/1",
+			expected: []storage.ObjectAttrs{
+				{Name: obj("job/1/artifacts/junit_01.xml")},
+				{Name: obj("job/1/finished.json")},
+				{Name: obj("job/1/started.json")},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewLocalClient()
+			it := client.Objects(context.Background(), resolveOrDie(root, tc.path), tc.delimiter, tc.offset)
+			var actual []storage.ObjectAttrs
+			for {
+				attrs, err := it.Next()
+				if errors.Is(err, iterator.Done) {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next() got unexpected error: %v", err)
+				}
+				actual = append(actual, storage.ObjectAttrs{
+					Name:     attrs.Name,
+					Prefix:   attrs.Prefix,
+					Metadata: attrs.Metadata,
+				})
+			}
+			if diff := cmp.Diff(actual, tc.expected); diff != "" {
+				t.Errorf("Objects() got unexpected diff (-have, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalListBuilds(t *testing.T) {
+	root, cleanup := localDir(t, map[string]string{
+		"job/1/started.json":          "{}",
+		"job/2/started.json":          "{}",
+		"pr-logs/pull/1/job/3/a.json": "{}",
+	}, map[string]string{
+		"job/3": "../pr-logs/pull/1/job/3",
+	})
+	defer cleanup()
+
+	builds, err := ListBuilds(context.Background(), NewLocalClient(), resolveOrDie(root, "job/"), nil)
+	if err != nil {
+		t.Fatalf("ListBuilds() got unexpected error: %v", err)
+	}
+	var actual []string
+	for _, b := range builds {
+		actual = append(actual, b.String())
+	}
+	expected := []string{
+		resolveOrDie(root, "pr-logs/pull/1/job/3/").String(),
+		resolveOrDie(root, "job/2/").String(),
+		resolveOrDie(root, "job/1/").String(),
+	}
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("ListBuilds() got unexpected diff (-have, +want):\n%s", diff)
+	}
+}
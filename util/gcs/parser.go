@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+// JUnitFormat is the name of the junit.xml report format.
+const JUnitFormat = "junit"
+
+// Format recognizes and parses a particular kind of test report artifact.
+type Format struct {
+	// Name identifies the format, such as "junit".
+	Name string
+	// Match returns the metadata extracted from the artifact name,
+	// or nil when the artifact is not a report in this format.
+	Match func(name string) map[string]string
+	// Parse converts the contents of a report into suites.
+	Parse func(buf []byte) (*junit.Suites, error)
+}
+
+var (
+	formatLock sync.RWMutex
+	formats    = map[string]Format{}
+)
+
+func init() {
+	if err := RegisterFormat(Format{
+		Name:  JUnitFormat,
+		Match: parseSuitesMeta,
+		Parse: parseJUnit,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func parseJUnit(buf []byte) (*junit.Suites, error) {
+	suites, err := junit.Parse(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &suites, nil
+}
+
+// RegisterFormat makes the format available to LookupFormats.
+//
+// Typically called from the init function of the package implementing the format.
+func RegisterFormat(f Format) error {
+	switch {
+	case f.Name == "":
+		return errors.New("empty name")
+	case f.Match == nil:
+		return fmt.Errorf("%s: nil Match", f.Name)
+	case f.Parse == nil:
+		return fmt.Errorf("%s: nil Parse", f.Name)
+	}
+	formatLock.Lock()
+	defer formatLock.Unlock()
+	if _, ok := formats[f.Name]; ok {
+		return fmt.Errorf("%s: already registered", f.Name)
+	}
+	formats[f.Name] = f
+	return nil
+}
+
+// LookupFormats returns the named formats, in order.
+//
+// Returns just the junit format when no names are specified.
+func LookupFormats(names ...string) ([]Format, error) {
+	if len(names) == 0 {
+		names = []string{JUnitFormat}
+	}
+	formatLock.RLock()
+	defer formatLock.RUnlock()
+	out := make([]Format, 0, len(names))
+	for _, name := range names {
+		f, ok := formats[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown format %q (known: %v)", name, formatNames())
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// formatNames returns the sorted names of every registered format.
+//
+// Requires the lock.
+func formatNames() []string {
+	out := make([]string, 0, len(formats))
+	for name := range formats {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// matchFormat returns the first format that recognizes the artifact, along with its metadata.
+func matchFormat(formats []Format, name string) (*Format, map[string]string) {
+	for i, f := range formats {
+		if meta := f.Match(name); meta != nil {
+			return &formats[i], meta
+		}
+	}
+	return nil, nil
+}
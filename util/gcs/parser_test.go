@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+func TestRegisterFormat(t *testing.T) {
+	match := func(string) map[string]string { return nil }
+	parse := func([]byte) (*junit.Suites, error) { return nil, nil }
+	cases := []struct {
+		name   string
+		format Format
+		err    bool
+	}{
+		{
+			name: "basically works",
+			format: Format{
+				Name:  "register-basic",
+				Match: match,
+				Parse: parse,
+			},
+		},
+		{
+			name: "reject empty name",
+			format: Format{
+				Match: match,
+				Parse: parse,
+			},
+			err: true,
+		},
+		{
+			name: "reject missing match",
+			format: Format{
+				Name:  "register-no-match",
+				Parse: parse,
+			},
+			err: true,
+		},
+		{
+			name: "reject missing parse",
+			format: Format{
+				Name:  "register-no-parse",
+				Match: match,
+			},
+			err: true,
+		},
+		{
+			name: "reject duplicate name",
+			format: Format{
+				Name:  JUnitFormat,
+				Match: match,
+				Parse: parse,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := RegisterFormat(tc.format)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("RegisterFormat() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("RegisterFormat() failed to receive an error")
+			default:
+				if _, err := LookupFormats(tc.format.Name); err != nil {
+					t.Errorf("LookupFormats(%q) got unexpected error: %v", tc.format.Name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLookupFormats(t *testing.T) {
+	if err := RegisterFormat(Format{
+		Name:  "lookup-other",
+		Match: func(string) map[string]string { return nil },
+		Parse: func([]byte) (*junit.Suites, error) { return nil, nil },
+	}); err != nil {
+		t.Fatalf("RegisterFormat() failed: %v", err)
+	}
+	cases := []struct {
+		name     string
+		names    []string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "default to junit",
+			expected: []string{JUnitFormat},
+		},
+		{
+			name:     "preserve order",
+			names:    []string{"lookup-other", JUnitFormat},
+			expected: []string{"lookup-other", JUnitFormat},
+		},
+		{
+			name:  "reject unknown formats",
+			names: []string{JUnitFormat, "lookup-unknown"},
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			formats, err := LookupFormats(tc.names...)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("LookupFormats() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("LookupFormats() failed to receive an error")
+			default:
+				var actual []string
+				for _, f := range formats {
+					actual = append(actual, f.Name)
+				}
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("LookupFormats() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchFormat(t *testing.T) {
+	const other = "match-other"
+	if err := RegisterFormat(Format{
+		Name:  other,
+		Match: func(string) map[string]string { return map[string]string{"Context": "other"} },
+		Parse: func([]byte) (*junit.Suites, error) { return nil, nil },
+	}); err != nil {
+		t.Fatalf("RegisterFormat() failed: %v", err)
+	}
+	cases := []struct {
+		name     string
+		formats  []string
+		artifact string
+		format   string
+		meta     map[string]string
+	}{
+		{
+			name:     "match junit",
+			artifact: "artifacts/junit_context_20180102-1256_07.xml",
+			format:   JUnitFormat,
+			meta: map[string]string{
+				"Context":   "context",
+				"Timestamp": "20180102-1256",
+				"Thread":    "07",
+			},
+		},
+		{
+			name:     "ignore other artifacts",
+			artifact: "artifacts/build-log.txt",
+		},
+		{
+			name:     "ignore json when only reading junit",
+			artifact: "artifacts/go-test.json",
+		},
+		{
+			name:     "match other formats",
+			formats:  []string{JUnitFormat, other},
+			artifact: "artifacts/go-test.json",
+			format:   other,
+			meta:     map[string]string{"Context": "other"},
+		},
+		{
+			name:     "prefer earlier formats",
+			formats:  []string{JUnitFormat, other},
+			artifact: "artifacts/junit.xml",
+			format:   JUnitFormat,
+			meta: map[string]string{
+				"Context":   "",
+				"Timestamp": "",
+				"Thread":    "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			formats, err := LookupFormats(tc.formats...)
+			if err != nil {
+				t.Fatalf("LookupFormats(%v) failed: %v", tc.formats, err)
+			}
+			format, meta := matchFormat(formats, tc.artifact)
+			var name string
+			if format != nil {
+				name = format.Name
+			}
+			if name != tc.format {
+				t.Errorf("matchFormat() got format %q, want %q", name, tc.format)
+			}
+			if diff := cmp.Diff(tc.meta, meta); diff != "" {
+				t.Errorf("matchFormat() got unexpected metadata diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
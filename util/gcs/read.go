@@ -53,12 +53,13 @@ type Finished struct {
 // Build points to a build stored under a particular gcs prefix.
 type Build struct {
 	Path              Path
+	Formats           []Format // test report formats to read, defaulting to junit
 	originalPrefix    string
 	suitesConcurrency int // override the max number of concurrent suite downloads
 }
 
 func (build Build) String() string {
-	return "gs://" + build.Path.String()
+	return build.Path.String()
 }
 
 // ListBuilds returns the array of builds under path, sorted in monotonically decreasing order.
@@ -109,8 +110,8 @@ func ListBuilds(parent context.Context, lister Lister, path Path, after *Path) (
 			continue // not a symlink to a directory
 		}
 
-		loc := "gs://" + path.Bucket() + "/" + objAttrs.Prefix
-		path, err := NewPath(loc)
+		loc := "/" + objAttrs.Prefix
+		path, err := path.ResolveReference(&url.URL{Path: loc})
 		if err != nil {
 			return nil, fmt.Errorf("bad path %q: %w", loc, err)
 		}
@@ -245,7 +246,7 @@ type SuitesMeta struct {
 	Path     string
 }
 
-func readSuites(ctx context.Context, opener Opener, p Path) (*junit.Suites, error) {
+func readSuites(ctx context.Context, opener Opener, p Path, parse func([]byte) (*junit.Suites, error)) (*junit.Suites, error) {
 	r, err := opener.Open(ctx, p)
 	if err != nil {
 		return nil, fmt.Errorf("open: %w", err)
@@ -255,17 +256,27 @@ func readSuites(ctx context.Context, opener Opener, p Path) (*junit.Suites, erro
 	if err != nil {
 		return nil, fmt.Errorf("read: %w", err)
 	}
-	suitesMeta, err := junit.Parse(buf)
+	suitesMeta, err := parse(buf)
 	if err != nil {
 		return nil, fmt.Errorf("parse: %w", err)
 	}
-	return &suitesMeta, nil
+	return suitesMeta, nil
 }
 
-// Suites takes a channel of artifact names, parses those representing junit suites, writing the result to the suites channel.
+// Suites takes a channel of artifact names, parses those representing test reports, writing the result to the suites channel.
 //
-// Note that junit suites are parsed in parallel, so there are no guarantees about suites ordering.
+// Reads reports in any of the build's Formats, preferring the earlier formats when several match.
+//
+// Note that suites are parsed in parallel, so there are no guarantees about suites ordering.
 func (build Build) Suites(parent context.Context, opener Opener, artifacts <-chan string, suites chan<- SuitesMeta) error {
+	formats := build.Formats
+	if len(formats) == 0 {
+		var err error
+		if formats, err = LookupFormats(); err != nil {
+			return fmt.Errorf("formats: %w", err)
+		}
+	}
+
 	var wg sync.WaitGroup
 	var work int
 
@@ -283,16 +294,16 @@ func (build Build) Suites(parent context.Context, opener Opener, artifacts <-cha
 	defer cancel()
 
 	for art := range artifacts {
-		meta := parseSuitesMeta(art)
-		if meta == nil {
-			continue // not a junit file ignore it, ignore it
+		format, meta := matchFormat(formats, art)
+		if format == nil {
+			continue // not a test report, ignore it
 		}
 		// concurrently parse each file because there may be a lot of them, and
 		// each takes a non-trivial amount of time waiting for the network.
 		work++
 		wg.Add(1)
 
-		go func(art string, meta map[string]string) {
+		go func(art string, parse func([]byte) (*junit.Suites, error), meta map[string]string) {
 			semaphore <- 1 // wait for free slot
 			defer wg.Done()
 			defer func() { <-semaphore }() // free up slot
@@ -311,7 +322,7 @@ func (build Build) Suites(parent context.Context, opener Opener, artifacts <-cha
 				Metadata: meta,
 				Path:     path.String(),
 			}
-			s, err := readSuites(ctx, opener, *path)
+			s, err := readSuites(ctx, opener, *path, parse)
 			if err != nil {
 				select {
 				case <-ctx.Done():
@@ -330,7 +341,7 @@ func (build Build) Suites(parent context.Context, opener Opener, artifacts <-cha
 			case <-ctx.Done():
 			case ec <- nil:
 			}
-		}(art, meta)
+		}(art, format.Parse, meta)
 	}
 
 	for ; work > 0; work-- {
@@ -27,6 +27,7 @@ import (
 	"net/url"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 
@@ -737,7 +738,7 @@ func TestReadSuites(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual, err := readSuites(tc.ctx, tc.opener, path)
+			actual, err := readSuites(tc.ctx, tc.opener, path, parseJUnit)
 			switch {
 			case err != nil:
 				if tc.expected != nil {
@@ -852,6 +853,7 @@ func TestSuites(t *testing.T) {
 		path        Path
 		artifacts   map[string]string
 		concurrency int
+		formats     []Format
 
 		expected []SuitesMeta
 		err      bool
@@ -999,6 +1001,52 @@ func TestSuites(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "support other formats",
+			path: newPathOrDie("gs://where/whatever"),
+			artifacts: map[string]string{
+				"/something/junit.xml":  `<testsuite><testcase name="foo"/></testsuite>`,
+				"/something/report.txt": "hello\nworld",
+			},
+			formats: []Format{
+				{
+					Name: "lines",
+					Match: func(name string) map[string]string {
+						if !strings.HasSuffix(name, ".txt") {
+							return nil
+						}
+						return map[string]string{"Context": "lines"}
+					},
+					Parse: func(buf []byte) (*junit.Suites, error) {
+						var suite junit.Suite
+						for _, line := range strings.Split(string(buf), "\n") {
+							suite.Results = append(suite.Results, junit.Result{Name: line})
+						}
+						return &junit.Suites{Suites: []junit.Suite{suite}}, nil
+					},
+				},
+			},
+			expected: []SuitesMeta{
+				{
+					Suites: junit.Suites{
+						Suites: []junit.Suite{
+							{
+								Results: []junit.Result{
+									{
+										Name: "hello",
+									},
+									{
+										Name: "world",
+									},
+								},
+							},
+						},
+					},
+					Metadata: map[string]string{"Context": "lines"},
+					Path:     "gs://where/something/report.txt",
+				},
+			},
+		},
 		{
 			name: "read suites error returns errors",
 			path: newPathOrDie("gs://where/whatever"),
@@ -1027,6 +1075,7 @@ func TestSuites(t *testing.T) {
 			fo := fakeOpener{}
 			b := Build{
 				Path:              tc.path,
+				Formats:           tc.formats,
 				suitesConcurrency: tc.concurrency,
 			}
 			for s, data := range tc.artifacts {
@@ -0,0 +1,441 @@
+/*
+Copyright 2020 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// S3Options configures a client for an S3-compatible object store.
+type S3Options struct {
+	// Endpoint of the store, such as https://s3.us-east-1.amazonaws.com or http://localhost:9000.
+	Endpoint string
+	// Region used to sign requests, defaults to us-east-1.
+	Region string
+	// AccessKey and SecretKey sign requests, which are anonymous when empty.
+	AccessKey string
+	SecretKey string
+	// HTTPClient sends requests, defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewS3Client returns a Client that reads and writes s3://bucket/obj paths.
+//
+// Buckets are addressed path-style (endpoint/bucket/obj), which works for
+// AWS as well as self-hosted stores like MinIO.
+// Stat reports an x-amz-meta-link header as an x-goog-meta-link, but S3
+// listings omit user metadata so ListBuilds does not follow these links;
+// point gcs_prefix at the builds themselves.
+func NewS3Client(opt S3Options) (Client, error) {
+	endpoint, err := url.Parse(opt.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse endpoint: %w", err)
+	}
+	if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("endpoint must be an http(s)://host url: %s", opt.Endpoint)
+	}
+	if (opt.AccessKey == "") != (opt.SecretKey == "") {
+		return nil, errors.New("access key and secret key must be set together")
+	}
+	if opt.Region == "" {
+		opt.Region = "us-east-1"
+	}
+	if opt.HTTPClient == nil {
+		opt.HTTPClient = http.DefaultClient
+	}
+	return &s3Client{
+		endpoint: *endpoint,
+		opt:      opt,
+		now:      time.Now,
+	}, nil
+}
+
+type s3Client struct {
+	endpoint url.URL
+	opt      S3Options
+	now      func() time.Time
+}
+
+// s3Error is the body of an S3 error response.
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// do sends a signed request for the object, returning an error for non-2XX responses.
+//
+// Returns storage.ErrObjectNotExist when the object or bucket is missing.
+func (sc *s3Client) do(ctx context.Context, method, bucket, object string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := sc.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket
+	if object != "" {
+		u.Path += "/" + object
+	}
+	u.RawPath = s3Escape(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for k, vals := range header {
+		req.Header[k] = vals
+	}
+	sc.sign(req, body)
+	resp, err := sc.opt.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, storage.ErrObjectNotExist
+	}
+	var s3err s3Error
+	if buf, err := ioutil.ReadAll(resp.Body); err == nil {
+		xml.Unmarshal(buf, &s3err)
+	}
+	return nil, fmt.Errorf("%s %s: %s: %s %s", method, u.String(), resp.Status, s3err.Code, s3err.Message)
+}
+
+func (sc *s3Client) Open(ctx context.Context, path Path) (io.ReadCloser, error) {
+	resp, err := sc.do(ctx, http.MethodGet, path.Bucket(), path.Object(), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := headerAttrs(path.Bucket(), path.Object(), resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return &attrsReader{
+		ReadCloser: resp.Body,
+		attrs: storage.ReaderObjectAttrs{
+			Size:         attrs.Size,
+			ContentType:  attrs.ContentType,
+			CacheControl: attrs.CacheControl,
+			LastModified: attrs.Updated,
+			Generation:   attrs.Generation,
+		},
+	}, nil
+}
+
+func (sc *s3Client) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	resp, err := sc.do(ctx, http.MethodHead, path.Bucket(), path.Object(), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return headerAttrs(path.Bucket(), path.Object(), resp)
+}
+
+// headerAttrs converts the headers of a GET or HEAD response into object attributes.
+//
+// S3 lacks generations, so the last modified time is used instead.
+func headerAttrs(bucket, name string, resp *http.Response) (*storage.ObjectAttrs, error) {
+	attrs := storage.ObjectAttrs{
+		Bucket:       bucket,
+		Name:         name,
+		Size:         resp.ContentLength,
+		ContentType:  resp.Header.Get("Content-Type"),
+		CacheControl: resp.Header.Get("Cache-Control"),
+		Etag:         resp.Header.Get("ETag"),
+	}
+	if mod := resp.Header.Get("Last-Modified"); mod != "" {
+		when, err := http.ParseTime(mod)
+		if err != nil {
+			return nil, fmt.Errorf("parse Last-Modified: %w", err)
+		}
+		attrs.Updated = when
+		attrs.Generation = when.UnixNano()
+	}
+	if link := resp.Header.Get("x-amz-meta-link"); link != "" {
+		attrs.Metadata = map[string]string{"x-goog-meta-link": link}
+	}
+	return &attrs, nil
+}
+
+func (sc *s3Client) Upload(ctx context.Context, path Path, buf []byte, worldReadable bool, cacheControl string) error {
+	header := http.Header{}
+	if worldReadable {
+		header.Set("x-amz-acl", "public-read")
+	}
+	if cacheControl != "" {
+		header.Set("Cache-Control", cacheControl)
+	}
+	resp, err := sc.do(ctx, http.MethodPut, path.Bucket(), path.Object(), nil, header, buf)
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", path, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Objects lists the objects under path with ListObjectsV2.
+//
+// GCS includes an object matching the start offset whereas S3 starts after it,
+// so the iterator starts slightly earlier and skips anything before the offset.
+// Listings do not include user metadata, so listed objects lack any link.
+func (sc *s3Client) Objects(ctx context.Context, path Path, delimiter, startOffset string) Iterator {
+	p := path.Object()
+	if !strings.HasSuffix(p, "/") {
+		p += "/"
+	}
+	return &s3Iterator{
+		ctx:       ctx,
+		client:    sc,
+		bucket:    path.Bucket(),
+		prefix:    p,
+		delimiter: delimiter,
+		offset:    startOffset,
+	}
+}
+
+// listResult is the body of a ListObjectsV2 response.
+type listResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+type s3Iterator struct {
+	ctx       context.Context
+	client    *s3Client
+	bucket    string
+	prefix    string
+	delimiter string
+	offset    string
+
+	token   string
+	done    bool
+	objects []*storage.ObjectAttrs
+}
+
+func (si *s3Iterator) Next() (*storage.ObjectAttrs, error) {
+	for len(si.objects) == 0 {
+		if si.done {
+			return nil, iterator.Done
+		}
+		if err := si.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	attrs := si.objects[0]
+	si.objects = si.objects[1:]
+	return attrs, nil
+}
+
+// fetch reads the next page of results.
+func (si *s3Iterator) fetch() error {
+	if err := si.ctx.Err(); err != nil {
+		return err
+	}
+	query := url.Values{
+		"list-type": {"2"},
+		"prefix":    {si.prefix},
+	}
+	if si.delimiter != "" {
+		query.Set("delimiter", si.delimiter)
+	}
+	if si.token != "" {
+		query.Set("continuation-token", si.token)
+	} else if after := startAfter(si.offset); after != "" {
+		query.Set("start-after", after)
+	}
+	resp, err := si.client.do(si.ctx, http.MethodGet, si.bucket, "", query, nil, nil)
+	if err != nil {
+		return fmt.Errorf("list s3://%s/%s: %w", si.bucket, si.prefix, err)
+	}
+	defer resp.Body.Close()
+	var result listResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode list: %w", err)
+	}
+	si.token = result.NextContinuationToken
+	si.done = !result.IsTruncated || si.token == ""
+
+	for _, obj := range result.Contents {
+		if obj.Key < si.offset {
+			continue
+		}
+		attrs := &storage.ObjectAttrs{
+			Bucket:     si.bucket,
+			Name:       obj.Key,
+			Size:       obj.Size,
+			Etag:       obj.ETag,
+			Updated:    obj.LastModified,
+			Generation: obj.LastModified.UnixNano(),
+		}
+		si.objects = append(si.objects, attrs)
+	}
+	for _, cp := range result.CommonPrefixes {
+		if cp.Prefix < si.offset && !strings.HasPrefix(si.offset, cp.Prefix) {
+			continue
+		}
+		si.objects = append(si.objects, &storage.ObjectAttrs{Bucket: si.bucket, Prefix: cp.Prefix})
+	}
+	sort.SliceStable(si.objects, func(i, j int) bool {
+		return si.objects[i].Name+si.objects[i].Prefix < si.objects[j].Name+si.objects[j].Prefix
+	})
+	return nil
+}
+
+// startAfter returns an S3 start-after value that sorts just before the offset.
+//
+// So abc becomes abb, which includes abc (and anything like abb-foo, which
+// the iterator skips).
+func startAfter(offset string) string {
+	if offset == "" {
+		return ""
+	}
+	last := len(offset) - 1
+	if offset[last] == 0 {
+		return offset[:last]
+	}
+	return offset[:last] + string([]byte{offset[last] - 1})
+}
+
+// sign adds AWS signature version 4 headers to the request.
+//
+// Signs the host and all headers set on the request.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (sc *s3Client) sign(req *http.Request, body []byte) {
+	if sc.opt.AccessKey == "" {
+		return
+	}
+	now := sc.now().UTC()
+	stamp := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	payload := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(payload[:])
+	req.Header.Set("x-amz-date", stamp)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, vals := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(vals, ","))
+	}
+	var names []string
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonHeaders strings.Builder
+	for _, k := range names {
+		canonHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{day, sc.opt.Region, "s3", "aws4_request"}, "/")
+	requestHash := sha256.Sum256([]byte(canonRequest))
+	toSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		stamp,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+sc.opt.SecretKey), day)
+	key = hmacSHA256(key, sc.opt.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, toSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sc.opt.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes the query with sorted keys, as signing requires.
+func canonicalQuery(query url.Values) string {
+	var keys []string
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vals := append([]string(nil), query[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Escape percent-encodes everything except unreserved characters.
+//
+// Slashes are also left alone unless escapeSlash is set.
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}